@@ -27,6 +27,7 @@ func printInfo() {
 	printTuple(fmat, "Configuration", config.OscConfigFile, color.Cyan)
 	printTuple(fmat, "Logs", config.OscLogs, color.Cyan)
 	printTuple(fmat, "Screen Dumps", config.OscDumpDir, color.Cyan)
+	printTuple(fmat, "Announcements", config.OscAnnounceFile, color.Cyan)
 }
 
 func printLogo(c color.Paint) {
@@ -3,6 +3,7 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"net/http"
 	"runtime/debug"
 
 	"github.com/open-infra/osc/internal/client"
@@ -38,9 +39,9 @@ var (
 )
 
 func init() {
-	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initOscFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(versionCmd(), infoCmd(), snapshotCmd())
 
 	var flags flag.FlagSet
 	klog.InitFlags(&flags)
@@ -80,7 +81,16 @@ func run(cmd *cobra.Command, args []string) {
 	}()
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
-	app := view.NewApp(loadConfiguration())
+	var rt http.RoundTripper
+	if file := *k9sFlags.FromSnapshot; file != "" {
+		t, cleanup, err := useSnapshot(file)
+		if err != nil {
+			panic(fmt.Sprintf("snapshot load failed -- %v", err))
+		}
+		defer cleanup()
+		rt = t
+	}
+	app := view.NewApp(loadConfiguration(rt))
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		panic(fmt.Sprintf("app init failed -- %v", err))
 	}
@@ -92,11 +102,14 @@ func run(cmd *cobra.Command, args []string) {
 	}
 }
 
-func loadConfiguration() *config.Config {
+func loadConfiguration(rt http.RoundTripper) *config.Config {
 	log.Info().Msg("☁️ Osc starting up...")
 
 	// Load K9s config file...
 	k8sCfg := client.NewConfig(k8sFlags)
+	if rt != nil {
+		k8sCfg.SetTransport(rt)
+	}
 	k9sCfg := config.NewConfig(k8sCfg)
 
 	if err := k9sCfg.Load(config.OscConfigFile); err != nil {
@@ -109,6 +122,7 @@ func loadConfiguration() *config.Config {
 
 	k9sCfg.Osc.OverrideHeadless(*k9sFlags.Headless)
 	k9sCfg.Osc.OverrideCrumbsless(*k9sFlags.Crumbsless)
+	k9sCfg.Osc.OverrideAccessible(*k9sFlags.Accessible)
 	k9sCfg.Osc.OverrideReadOnly(*k9sFlags.ReadOnly)
 	k9sCfg.Osc.OverrideWrite(*k9sFlags.Write)
 	k9sCfg.Osc.OverrideCommand(*k9sFlags.Command)
@@ -186,6 +200,12 @@ func initOscFlags() {
 		false,
 		"Turn K9s crumbs off",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.Accessible,
+		"accessible",
+		false,
+		"Turn K9s accessibility mode on",
+	)
 	rootCmd.Flags().BoolVarP(
 		k9sFlags.AllNamespaces,
 		"all-namespaces", "A",
@@ -210,6 +230,12 @@ func initOscFlags() {
 		false,
 		"Sets write mode by overriding the readOnly configuration setting",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.FromSnapshot,
+		"from-snapshot",
+		"",
+		"Browse a cluster snapshot bundle read-only instead of a live cluster",
+	)
 }
 
 func initK8sFlags() {
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/color"
+	"github.com/open-infra/osc/internal/snapshot"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var invalidPathCharsRX = regexp.MustCompile(`[^\w.-]+`)
+
+func snapshotCmd() *cobra.Command {
+	var (
+		namespaces []string
+		output     string
+		tailLines  int64
+	)
+
+	command := cobra.Command{
+		Use:   "snapshot",
+		Short: "Capture a cluster state snapshot",
+		Long:  "Capture resources, events, recent logs and metrics into a tarball that can be browsed later using --from-snapshot",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := captureSnapshot(namespaces, output, tailLines); err != nil {
+				fmt.Println(color.Colorize(fmt.Sprintf("Snapshot failed -- %v", err), color.Red))
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringSliceVarP(&namespaces, "namespaces", "n", nil, "Namespaces to capture. Captures all namespaces if not set")
+	command.Flags().StringVarP(&output, "output", "o", "", "Snapshot file path. Defaults to osc-snapshot-CONTEXT-TIMESTAMP.tar.gz")
+	command.Flags().Int64Var(&tailLines, "tail-lines", snapshot.DefaultTailLines, "Number of log lines to capture per container")
+	command.Flags().StringVar(k8sFlags.KubeConfig, "kubeconfig", "", "Path to the kubeconfig file to use for CLI requests")
+	command.Flags().StringVar(k8sFlags.Context, "context", "", "The name of the kubeconfig context to use")
+
+	return &command
+}
+
+func captureSnapshot(namespaces []string, output string, tailLines int64) error {
+	const fmat = "%-60s %s\n"
+
+	conn, err := client.InitConnection(client.NewConfig(k8sFlags))
+	if err != nil {
+		return err
+	}
+	if !conn.CheckConnectivity() {
+		return fmt.Errorf("unable to connect to cluster")
+	}
+
+	printLogo(color.Cyan)
+	opts := snapshot.Options{
+		Namespaces: namespaces,
+		TailLines:  tailLines,
+		Progress: func(gvr string, count int, err error) {
+			if err != nil {
+				printTuple(fmat, gvr, "skipped", color.Red)
+				return
+			}
+			printTuple(fmat, gvr, strconv.Itoa(count), color.Cyan)
+		},
+	}
+	b, err := snapshot.Capture(context.Background(), conn, opts)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = fmt.Sprintf("osc-snapshot-%s-%s.tar.gz",
+			invalidPathCharsRX.ReplaceAllString(b.Manifest.Context, "_"),
+			b.Manifest.CreatedAt.Format("20060102-150405"),
+		)
+	}
+	if err := b.Save(output); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	printTuple(fmat, "Snapshot", output, color.Cyan)
+	printTuple(fmat, "Resources", strconv.Itoa(len(b.Manifest.Resources)), color.Cyan)
+	printTuple(fmat, "Skipped", strconv.Itoa(len(b.Manifest.Skipped)), color.Cyan)
+
+	return nil
+}
+
+// useSnapshot points the kubeconfig flags to a snapshot bundle served read-only
+// by the returned transport.
+func useSnapshot(file string) (http.RoundTripper, func(), error) {
+	b, err := snapshot.Load(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	dir, err := ioutil.TempDir("", "osc-snapshot")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Error().Err(err).Msgf("Snapshot cleanup failed")
+		}
+	}
+	kubeConfig := filepath.Join(dir, "config")
+	if err := b.WriteKubeConfig(kubeConfig); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	var blank string
+	ctx := b.Manifest.Context
+	k8sFlags.KubeConfig, k8sFlags.Context = &kubeConfig, &ctx
+	k8sFlags.ClusterName, k8sFlags.AuthInfoName = &blank, &blank
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+	log.Info().Msgf("📦 Browsing snapshot %q taken on %s", file, b.Manifest.CreatedAt.Format(time.RFC1123))
+
+	return snapshot.NewTransport(b), cleanup, nil
+}
@@ -241,7 +241,7 @@ func (a *APIClient) CheckConnectivity() bool {
 	}()
 
 	// Need to reload to pickup any kubeconfig changes.
-	cfg, err := NewConfig(a.config.flags).RESTConfig()
+	cfg, err := a.config.Clone().RESTConfig()
 	if err != nil {
 		log.Error().Err(err).Msgf("restConfig load failed")
 		a.connOK = false
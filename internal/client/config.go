@@ -3,6 +3,9 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +29,7 @@ type Config struct {
 	clientConfig clientcmd.ClientConfig
 	rawConfig    *clientcmdapi.Config
 	restConfig   *restclient.Config
+	transport    http.RoundTripper
 	mutex        *sync.RWMutex
 }
 
@@ -70,6 +74,20 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// SetTransport overrides the api server transport ie to serve a cluster snapshot.
+func (c *Config) SetTransport(rt http.RoundTripper) {
+	c.transport = rt
+	c.restConfig = nil
+}
+
+// Clone returns a fresh config sharing the same flags and transport.
+func (c *Config) Clone() *Config {
+	cfg := NewConfig(c.flags)
+	cfg.transport = c.transport
+
+	return cfg
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -121,6 +139,195 @@ func (c *Config) DelContext(n string) error {
 	return clientcmd.ModifyConfig(c.clientConfig.ConfigAccess(), cfg, true)
 }
 
+// RenameContext renames a context and tracks it if currently active.
+func (c *Config) RenameContext(n, newName string) error {
+	cfg, err := c.rawConfigCopy()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return fmt.Errorf("context %s does not exist", n)
+	}
+	if _, ok := cfg.Contexts[newName]; ok {
+		return fmt.Errorf("context %s already exists", newName)
+	}
+	delete(cfg.Contexts, n)
+	cfg.Contexts[newName] = ctx
+	if cfg.CurrentContext == n {
+		cfg.CurrentContext = newName
+	}
+	if err := c.modifyConfig(cfg); err != nil {
+		return err
+	}
+	if c.flags.Context != nil && *c.flags.Context == n {
+		c.flags.Context = &newName
+	}
+
+	return nil
+}
+
+// DupContext copies an existing context under a new name.
+func (c *Config) DupContext(n, dupName string) error {
+	cfg, err := c.rawConfigCopy()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return fmt.Errorf("context %s does not exist", n)
+	}
+	if _, ok := cfg.Contexts[dupName]; ok {
+		return fmt.Errorf("context %s already exists", dupName)
+	}
+	dup := ctx.DeepCopy()
+	dup.LocationOfOrigin = ""
+	cfg.Contexts[dupName] = dup
+
+	return c.modifyConfig(cfg)
+}
+
+// SetContextNamespace sets a context default namespace.
+func (c *Config) SetContextNamespace(n, ns string) error {
+	cfg, err := c.rawConfigCopy()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return fmt.Errorf("context %s does not exist", n)
+	}
+	ctx.Namespace = ns
+
+	return c.modifyConfig(cfg)
+}
+
+// MergeConfig merges the contexts of a kubeconfig file along with their
+// clusters and users into the current configuration. Existing contexts are
+// preserved and clusters or users colliding with a different existing entry
+// are imported under a new name. Returns the names of the merged contexts.
+func (c *Config) MergeConfig(path string) ([]string, error) {
+	in, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.Contexts) == 0 {
+		return nil, fmt.Errorf("no contexts found in %s", path)
+	}
+	if err := clientcmd.ResolveLocalPaths(in); err != nil {
+		return nil, err
+	}
+	cfg, err := c.rawConfigCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	nn := mergeContexts(&cfg, in)
+	if len(nn) == 0 {
+		return nil, fmt.Errorf("all contexts in %s already exist", path)
+	}
+
+	return nn, c.modifyConfig(cfg)
+}
+
+// mergeContexts merges the new contexts of a kubeconfig into a configuration
+// and returns their sorted names.
+func mergeContexts(cfg, in *clientcmdapi.Config) []string {
+	clusters, users := make(map[string]string), make(map[string]string)
+	nn := make([]string, 0, len(in.Contexts))
+	for k, v := range in.Contexts {
+		if _, ok := cfg.Contexts[k]; ok {
+			continue
+		}
+		if cl, ok := in.Clusters[v.Cluster]; ok {
+			v.Cluster = mergeEntry(clusters, v.Cluster, func(n string) (bool, bool) {
+				old, ok := cfg.Clusters[n]
+				return ok, ok && sameCluster(old, cl)
+			}, func(n string) {
+				cl.LocationOfOrigin = ""
+				cfg.Clusters[n] = cl
+			})
+		}
+		if u, ok := in.AuthInfos[v.AuthInfo]; ok {
+			v.AuthInfo = mergeEntry(users, v.AuthInfo, func(n string) (bool, bool) {
+				old, ok := cfg.AuthInfos[n]
+				return ok, ok && sameAuthInfo(old, u)
+			}, func(n string) {
+				u.LocationOfOrigin = ""
+				cfg.AuthInfos[n] = u
+			})
+		}
+		v.LocationOfOrigin = ""
+		cfg.Contexts[k] = v
+		nn = append(nn, k)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// mergeEntry returns the name an incoming cluster or user is merged under.
+// An existing identical entry is reused, a colliding one yields a new name.
+func mergeEntry(merged map[string]string, n string, lookup func(string) (bool, bool), add func(string)) string {
+	if name, ok := merged[n]; ok {
+		return name
+	}
+	name := n
+	for i := 1; ; i++ {
+		exists, same := lookup(name)
+		if same {
+			break
+		}
+		if !exists {
+			add(name)
+			break
+		}
+		name = fmt.Sprintf("%s-%d", n, i)
+	}
+	merged[n] = name
+
+	return name
+}
+
+func sameCluster(a, b *clientcmdapi.Cluster) bool {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	a.LocationOfOrigin, b.LocationOfOrigin = "", ""
+
+	return reflect.DeepEqual(a, b)
+}
+
+func sameAuthInfo(a, b *clientcmdapi.AuthInfo) bool {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	a.LocationOfOrigin, b.LocationOfOrigin = "", ""
+
+	return reflect.DeepEqual(a, b)
+}
+
+func (c *Config) rawConfigCopy() (clientcmdapi.Config, error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return cfg, err
+	}
+
+	return *cfg.DeepCopy(), nil
+}
+
+// modifyConfig persists the configuration and forces a kubeconfig reload.
+func (c *Config) modifyConfig(cfg clientcmdapi.Config) error {
+	acc, err := c.ConfigAccess()
+	if err != nil {
+		return err
+	}
+	if err := clientcmd.ModifyConfig(acc, cfg, true); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.clientConfig, c.rawConfig = nil, nil
+	c.mutex.Unlock()
+
+	return nil
+}
+
 // ContextNames fetch all available contexts.
 func (c *Config) ContextNames() ([]string, error) {
 	cfg, err := c.RawConfig()
@@ -313,6 +520,9 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	}
 	c.restConfig.QPS = defaultQPS
 	c.restConfig.Burst = defaultBurst
+	if c.transport != nil {
+		c.restConfig.Transport = c.transport
+	}
 
 	return c.restConfig, nil
 }
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
+)
+
+func TestMergeContexts(t *testing.T) {
+	cfg, err := clientcmd.LoadFromFile("./testdata/config")
+	assert.Nil(t, err)
+	in, err := clientcmd.Load([]byte(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    insecure-skip-tls-verify: true
+    server: https://localhost:4000
+  name: fred
+- cluster:
+    insecure-skip-tls-verify: true
+    server: https://localhost:3001
+  name: blee
+contexts:
+- context:
+    cluster: fred
+    user: fred
+  name: zorg
+- context:
+    cluster: blee
+    user: fred
+  name: bozo
+- context:
+    cluster: fred
+    user: fred
+  name: fred
+users:
+- name: fred
+  user:
+    token: zorg
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"bozo", "zorg"}, mergeContexts(cfg, in))
+	assert.Equal(t, "fred-1", cfg.Contexts["zorg"].Cluster)
+	assert.Equal(t, "fred-1", cfg.Contexts["zorg"].AuthInfo)
+	assert.Equal(t, "blee", cfg.Contexts["bozo"].Cluster)
+	assert.Equal(t, "fred-1", cfg.Contexts["bozo"].AuthInfo)
+	assert.Equal(t, "fred", cfg.Contexts["fred"].Cluster)
+	assert.Equal(t, "https://localhost:3000", cfg.Clusters["fred"].Server)
+	assert.Equal(t, "https://localhost:4000", cfg.Clusters["fred-1"].Server)
+	assert.Equal(t, "zorg", cfg.AuthInfos["fred-1"].Token)
+	assert.Equal(t, 4, len(cfg.Clusters))
+	assert.Equal(t, 4, len(cfg.AuthInfos))
+}
@@ -3,6 +3,9 @@ package client_test
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
 	"testing"
 
 	"github.com/open-infra/osc/internal/client"
@@ -249,3 +252,66 @@ func TestNamespaceNames(t *testing.T) {
 	assert.Equal(t, 2, len(nns))
 	assert.Equal(t, []string{"ns1", "ns2"}, nns)
 }
+
+func TestConfigRenameContext(t *testing.T) {
+	kubeConfig := tempKubeConfig(t)
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	assert.NotNil(t, cfg.RenameContext("fred", "blee"))
+	assert.NotNil(t, cfg.RenameContext("bozo", "zorg"))
+}
+
+func TestConfigDupContext(t *testing.T) {
+	kubeConfig := tempKubeConfig(t)
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	assert.NotNil(t, cfg.DupContext("fred", "blee"))
+	assert.NotNil(t, cfg.DupContext("bozo", "zorg"))
+}
+
+func TestConfigSetContextNamespace(t *testing.T) {
+	kubeConfig := tempKubeConfig(t)
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	assert.NotNil(t, cfg.SetContextNamespace("bozo", "ns1"))
+}
+
+func TestConfigMergeConfig(t *testing.T) {
+	kubeConfig := tempKubeConfig(t)
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	_, err := cfg.MergeConfig("./testdata/config")
+	assert.EqualError(t, err, "all contexts in ./testdata/config already exist")
+	_, err = cfg.MergeConfig("./testdata/bozo")
+	assert.NotNil(t, err)
+	cc, err := cfg.ContextNames()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(cc))
+}
+
+func TestConfigSetTransport(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Nil(t, rc.Transport)
+
+	rt := http.DefaultTransport
+	cfg.SetTransport(rt)
+	rc, err = cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, rt, rc.Transport)
+
+	rc, err = cfg.Clone().RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, rt, rc.Transport)
+}
+
+func tempKubeConfig(t *testing.T) string {
+	raw, err := ioutil.ReadFile("./testdata/config")
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "config")
+	assert.Nil(t, ioutil.WriteFile(path, raw, 0600))
+
+	return path
+}
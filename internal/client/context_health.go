@@ -0,0 +1,178 @@
+package client
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// ContextPending represents a context not probed yet.
+	ContextPending = "PENDING"
+
+	// ContextHealthy represents a reachable context with valid credentials.
+	ContextHealthy = "OK"
+
+	// ContextUnreachable represents a context api server that can not be reached.
+	ContextUnreachable = "UNREACHABLE"
+
+	// ContextUnauthorized represents a context with rejected credentials.
+	ContextUnauthorized = "UNAUTHORIZED"
+
+	// ContextExpired represents a context with expired credentials.
+	ContextExpired = "EXPIRED"
+
+	// ContextExpiring represents a context with credentials about to expire.
+	ContextExpiring = "EXPIRING"
+
+	// ContextInvalid represents a context that can not be loaded.
+	ContextInvalid = "INVALID"
+
+	// CredsExpiryWarning represents the time before credentials expiry to warn on.
+	CredsExpiryWarning = 7 * 24 * time.Hour
+)
+
+// ContextHealth tracks a context connectivity and credentials state.
+type ContextHealth struct {
+	Invalid   bool
+	Reachable bool
+	AuthValid bool
+	Version   string
+	Expiry    time.Time
+	Err       error
+}
+
+// Status returns a context health summary.
+func (h ContextHealth) Status(now time.Time) string {
+	switch {
+	case !h.Expiry.IsZero() && now.After(h.Expiry):
+		return ContextExpired
+	case h.Invalid:
+		return ContextInvalid
+	case !h.Reachable:
+		return ContextUnreachable
+	case !h.AuthValid:
+		return ContextUnauthorized
+	case !h.Expiry.IsZero() && h.Expiry.Sub(now) < CredsExpiryWarning:
+		return ContextExpiring
+	default:
+		return ContextHealthy
+	}
+}
+
+// ProbeContext checks a context api server connectivity, credentials and version.
+func ProbeContext(cfg clientcmdapi.Config, n string, timeout time.Duration) ContextHealth {
+	var h ContextHealth
+	h.Expiry, _ = CredsExpiry(cfg, n)
+
+	rc, err := clientcmd.NewNonInteractiveClientConfig(cfg, n, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		h.Invalid, h.Err = true, err
+		return h
+	}
+	rc.Timeout = timeout
+	dial, err := discovery.NewDiscoveryClientForConfig(rc)
+	if err != nil {
+		h.Invalid, h.Err = true, err
+		return h
+	}
+
+	info, err := dial.ServerVersion()
+	if err != nil && !isAuthErr(err) {
+		h.Err = err
+		return h
+	}
+	h.Reachable = true
+	if info != nil {
+		h.Version = info.GitVersion
+	}
+	if err == nil {
+		_, err = dial.ServerGroups()
+	}
+	if err != nil {
+		h.Err = err
+		h.AuthValid = !isAuthErr(err)
+		return h
+	}
+	h.AuthValid = true
+
+	return h
+}
+
+// CredsExpiry returns the expiry of a context client certificate or bearer
+// token if any.
+func CredsExpiry(cfg clientcmdapi.Config, n string) (time.Time, bool) {
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return time.Time{}, false
+	}
+	auth, ok := cfg.AuthInfos[ctx.AuthInfo]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	certData := auth.ClientCertificateData
+	if len(certData) == 0 && auth.ClientCertificate != "" {
+		certData, _ = ioutil.ReadFile(auth.ClientCertificate)
+	}
+	if t, ok := certExpiry(certData); ok {
+		return t, true
+	}
+
+	token := auth.Token
+	if token == "" && auth.TokenFile != "" {
+		raw, _ := ioutil.ReadFile(auth.TokenFile)
+		token = strings.TrimSpace(string(raw))
+	}
+
+	return tokenExpiry(token)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isAuthErr(err error) bool {
+	return errors.IsUnauthorized(err) || errors.IsForbidden(err)
+}
+
+func certExpiry(data []byte) (time.Time, bool) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return cert.NotAfter, true
+}
+
+// tokenExpiry extracts a JWT bearer token expiry claim.
+func tokenExpiry(token string) (time.Time, bool) {
+	tokens := strings.Split(token, ".")
+	if len(tokens) != 3 {
+		return time.Time{}, false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokens[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(raw, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
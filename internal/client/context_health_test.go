@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/stretchr/testify/assert"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestContextHealthStatus(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		h client.ContextHealth
+		e string
+	}{
+		"healthy": {
+			h: client.ContextHealth{Reachable: true, AuthValid: true},
+			e: client.ContextHealthy,
+		},
+		"invalid": {
+			h: client.ContextHealth{Invalid: true, Err: errors.New("boom")},
+			e: client.ContextInvalid,
+		},
+		"unreachable": {
+			h: client.ContextHealth{Err: errors.New("boom")},
+			e: client.ContextUnreachable,
+		},
+		"unauthorized": {
+			h: client.ContextHealth{Reachable: true},
+			e: client.ContextUnauthorized,
+		},
+		"expired": {
+			h: client.ContextHealth{Reachable: true, AuthValid: true, Expiry: now.Add(-time.Hour)},
+			e: client.ContextExpired,
+		},
+		"expiring": {
+			h: client.ContextHealth{Reachable: true, AuthValid: true, Expiry: now.Add(time.Hour)},
+			e: client.ContextExpiring,
+		},
+		"not-expiring": {
+			h: client.ContextHealth{Reachable: true, AuthValid: true, Expiry: now.Add(30 * 24 * time.Hour)},
+			e: client.ContextHealthy,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.h.Status(now))
+		})
+	}
+}
+
+func TestCredsExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	uu := map[string]struct {
+		token string
+		ok    bool
+	}{
+		"jwt": {
+			token: "e30." + claims + ".sig",
+			ok:    true,
+		},
+		"opaque": {
+			token: "fred",
+		},
+		"no-exp": {
+			token: "e30.e30.sig",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := clientcmdapi.Config{
+				Contexts:  map[string]*clientcmdapi.Context{"fred": {AuthInfo: "fred"}},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{"fred": {Token: u.token}},
+			}
+			at, ok := client.CredsExpiry(cfg, "fred")
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.True(t, exp.Equal(at))
+			}
+		})
+	}
+}
+
+func TestProbeContextInvalid(t *testing.T) {
+	cfg := clientcmdapi.Config{
+		Contexts: map[string]*clientcmdapi.Context{"fred": {Cluster: "blee", AuthInfo: "fred"}},
+	}
+	h := client.ProbeContext(cfg, "fred", time.Second)
+
+	assert.True(t, h.Invalid)
+	assert.Equal(t, client.ContextInvalid, h.Status(time.Now()))
+}
@@ -36,6 +36,8 @@ func TestGVRCan(t *testing.T) {
 		"no_delete": {[]string{"get", "list", "watch"}, "delete", false},
 		"edit":      {[]string{"path", "update", "watch"}, "edit", true},
 		"no_edit":   {[]string{"get", "list", "watch"}, "edit", false},
+		"patch":     {[]string{"patch", "watch"}, "patch", true},
+		"no_patch":  {[]string{"update", "watch"}, "patch", false},
 	}
 
 	for k := range uu {
@@ -143,6 +143,7 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("quit", "q", "Q")
 	a.declare("aliases", "alias", "a")
 	a.declare("popeye", "pop")
+	a.declare("popeyeruns", "popeyerun", "poph")
 	a.declare("helm", "charts", "chart", "hm")
 	a.declare("dir", "d")
 	a.declare("contexts", "context", "ctx")
@@ -153,6 +154,11 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("screendumps", "screendump", "sd")
 	a.declare("pulses", "pulse", "pu", "hz")
 	a.declare("xrays", "xray", "x")
+	a.declare("quotas", "quota", "qu")
+	a.declare("certs", "cert", "tls")
+	a.declare("routes", "route", "rt")
+	a.declare("storage", "sto", "vols")
+	a.declare("allocations", "allocation", "alloc")
 }
 
 // Save alias to disk.
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+var bookmarkRX = regexp.MustCompile(`\A[\w.-]+\z`)
+
+// Bookmark tracks a named view setup.
+type Bookmark struct {
+	Command    string `yaml:"command"`
+	Namespace  string `yaml:"namespace,omitempty"`
+	Filter     string `yaml:"filter,omitempty"`
+	SortColumn string `yaml:"sortColumn,omitempty"`
+	SortAsc    bool   `yaml:"sortAsc"`
+	Wide       bool   `yaml:"wide,omitempty"`
+	Toast      bool   `yaml:"toast,omitempty"`
+}
+
+// Bookmarks tracks a collection of bookmarks by name.
+type Bookmarks map[string]Bookmark
+
+// Names returns the sorted bookmark names.
+func (b Bookmarks) Names() []string {
+	nn := make([]string, 0, len(b))
+	for n := range b {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Validate drops bookmarks with invalid names or no command.
+func (b Bookmarks) Validate() {
+	for n, bm := range b {
+		if !bookmarkRX.MatchString(n) || bm.Command == "" {
+			delete(b, n)
+		}
+	}
+}
+
+// ValidateBookmarkName checks a bookmark name is usable from the command prompt.
+func ValidateBookmarkName(n string) error {
+	if !bookmarkRX.MatchString(n) {
+		return fmt.Errorf("invalid bookmark name %q. Only letters, digits, `_`, `.` and `-` are allowed", n)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarksValidate(t *testing.T) {
+	bb := config.Bookmarks{
+		"prod-pods": {Command: "po", Namespace: "prod"},
+		"bad name":  {Command: "po"},
+		"blank":     {},
+	}
+	bb.Validate()
+
+	assert.Equal(t, []string{"prod-pods"}, bb.Names())
+}
+
+func TestValidateBookmarkName(t *testing.T) {
+	assert.Nil(t, config.ValidateBookmarkName("fred_1.blee-2"))
+	assert.Error(t, config.ValidateBookmarkName(""))
+	assert.Error(t, config.ValidateBookmarkName("fred blee"))
+}
+
+func TestConfigBookmarks(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	assert.Equal(t, 0, len(cfg.Bookmarks()))
+
+	bm := config.Bookmark{Command: "dp", Namespace: "kube-system", Filter: "-l app=fred", SortColumn: "AGE", Wide: true}
+	assert.Nil(t, cfg.SetBookmark("sys", bm))
+	assert.Error(t, cfg.SetBookmark("sys dp", bm))
+	assert.Equal(t, bm, cfg.Bookmarks()["sys"])
+
+	assert.Nil(t, cfg.DeleteBookmark("sys"))
+	assert.Error(t, cfg.DeleteBookmark("sys"))
+	assert.Equal(t, 0, len(cfg.Bookmarks()))
+}
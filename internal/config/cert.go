@@ -0,0 +1,57 @@
+package config
+
+const (
+	defaultCertWarnDays     = 30
+	defaultCertCriticalDays = 7
+)
+
+// CertExpiry tracks certificates expiry windows in days.
+type CertExpiry struct {
+	Critical int `yaml:"critical"`
+	Warn     int `yaml:"warn"`
+}
+
+// NewCertExpiry returns a new instance.
+func NewCertExpiry() *CertExpiry {
+	return &CertExpiry{
+		Critical: defaultCertCriticalDays,
+		Warn:     defaultCertWarnDays,
+	}
+}
+
+// Validate checks the expiry windows. If not sane use defaults.
+func (c *CertExpiry) Validate() {
+	if c.Critical <= 0 {
+		c.Critical = defaultCertCriticalDays
+	}
+	if c.Warn <= 0 {
+		c.Warn = defaultCertWarnDays
+	}
+	if c.Warn < c.Critical {
+		c.Warn = c.Critical
+	}
+}
+
+// LevelFor returns a severity level given the days left before expiry.
+func (c *CertExpiry) LevelFor(days int) SeverityLevel {
+	if days <= c.Critical {
+		return SeverityHigh
+	}
+	if days <= c.Warn {
+		return SeverityMedium
+	}
+
+	return SeverityLow
+}
+
+// SeverityColor returns the color associated with the days left before expiry.
+func (c *CertExpiry) SeverityColor(days int) string {
+	switch c.LevelFor(days) {
+	case SeverityHigh:
+		return "red"
+	case SeverityMedium:
+		return "orangered"
+	default:
+		return "green"
+	}
+}
@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertExpiryValidate(t *testing.T) {
+	uu := map[string]struct {
+		c, e *config.CertExpiry
+	}{
+		"default": {
+			c: config.NewCertExpiry(),
+			e: config.NewCertExpiry(),
+		},
+		"blank": {
+			c: &config.CertExpiry{},
+			e: config.NewCertExpiry(),
+		},
+		"custom": {
+			c: &config.CertExpiry{Critical: 14, Warn: 60},
+			e: &config.CertExpiry{Critical: 14, Warn: 60},
+		},
+		"inverted": {
+			c: &config.CertExpiry{Critical: 14, Warn: 3},
+			e: &config.CertExpiry{Critical: 14, Warn: 14},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.c.Validate()
+			assert.Equal(t, u.e, u.c)
+		})
+	}
+}
+
+func TestCertExpiryLevelFor(t *testing.T) {
+	uu := map[string]struct {
+		days int
+		e    config.SeverityLevel
+		c    string
+	}{
+		"expired":  {days: -2, e: config.SeverityHigh, c: "red"},
+		"critical": {days: 7, e: config.SeverityHigh, c: "red"},
+		"warn":     {days: 20, e: config.SeverityMedium, c: "orangered"},
+		"ok":       {days: 90, e: config.SeverityLow, c: "green"},
+	}
+
+	c := config.NewCertExpiry()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, c.LevelFor(u.days))
+			assert.Equal(t, u.c, c.SeverityColor(u.days))
+		})
+	}
+}
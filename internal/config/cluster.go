@@ -12,6 +12,8 @@ type Cluster struct {
 	FeatureGates       *FeatureGates `yaml:"featureGates"`
 	ShellPod           *ShellPod     `yaml:"shellPod"`
 	PortForwardAddress string        `yaml:"portForwardAddress"`
+	Bookmarks          Bookmarks     `yaml:"bookmarks,omitempty"`
+	Split              *Split        `yaml:"split,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -48,4 +50,14 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 		c.ShellPod = NewShellPod()
 	}
 	c.ShellPod.Validate(conn, ks)
+
+	c.Bookmarks.Validate()
+
+	if c.Split != nil {
+		if !c.Split.IsValid() {
+			c.Split = nil
+		} else {
+			c.Split.Validate()
+		}
+	}
 }
@@ -26,6 +26,8 @@ var (
 	OscLogs = filepath.Join(os.TempDir(), fmt.Sprintf("osc-%s.log", MustOscUser()))
 	// OscDumpDir represents a directory where Osc screen dumps will be persisted.
 	OscDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("osc-screens-%s", MustOscUser()))
+	// OscAnnounceFile represents Osc accessibility announcements log.
+	OscAnnounceFile = filepath.Join(os.TempDir(), fmt.Sprintf("osc-announce-%s.log", MustOscUser()))
 )
 
 type (
@@ -185,6 +187,64 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// Bookmarks returns the current cluster bookmarks.
+func (c *Config) Bookmarks() Bookmarks {
+	cl := c.Osc.ActiveCluster()
+	if cl == nil {
+		return nil
+	}
+
+	return cl.Bookmarks
+}
+
+// SetBookmark saves a bookmark in the current cluster.
+func (c *Config) SetBookmark(name string, b Bookmark) error {
+	if err := ValidateBookmarkName(name); err != nil {
+		return err
+	}
+	cl := c.Osc.ActiveCluster()
+	if cl == nil {
+		return errors.New("no active cluster")
+	}
+	if cl.Bookmarks == nil {
+		cl.Bookmarks = make(Bookmarks)
+	}
+	cl.Bookmarks[name] = b
+
+	return nil
+}
+
+// DeleteBookmark removes a bookmark from the current cluster.
+func (c *Config) DeleteBookmark(name string) error {
+	cl := c.Osc.ActiveCluster()
+	if cl == nil {
+		return errors.New("no active cluster")
+	}
+	if _, ok := cl.Bookmarks[name]; !ok {
+		return fmt.Errorf("no bookmark named %q", name)
+	}
+	delete(cl.Bookmarks, name)
+
+	return nil
+}
+
+// ActiveSplit returns the current cluster split layout if any.
+func (c *Config) ActiveSplit() *Split {
+	cl := c.Osc.ActiveCluster()
+	if cl == nil {
+		return nil
+	}
+
+	return cl.Split
+}
+
+// SetActiveSplit saves the current cluster split layout.
+func (c *Config) SetActiveSplit(s *Split) {
+	if cl := c.Osc.ActiveCluster(); cl != nil {
+		cl.Split = s
+	}
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.client
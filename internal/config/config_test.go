@@ -267,6 +267,7 @@ var expectedConfig = `k9s:
   crumbsless: false
   readOnly: true
   noIcons: false
+  accessible: false
   logger:
     tail: 500
     buffer: 800
@@ -340,6 +341,9 @@ var expectedConfig = `k9s:
     memory:
       critical: 90
       warn: 70
+  certExpiry:
+    critical: 7
+    warn: 30
 `
 
 var resetConfig = `k9s:
@@ -350,6 +354,7 @@ var resetConfig = `k9s:
   crumbsless: false
   readOnly: false
   noIcons: false
+  accessible: false
   logger:
     tail: 200
     buffer: 2000
@@ -383,4 +388,7 @@ var resetConfig = `k9s:
     memory:
       critical: 90
       warn: 70
+  certExpiry:
+    critical: 7
+    warn: 30
 `
@@ -0,0 +1,103 @@
+package config
+
+// HighContrastSkin loads the built-in high contrast skin. Status colors are
+// kept distinct and steer clear of red/green pairings.
+func (s *Styles) HighContrastSkin() {
+	s.K9s = newHighContrastStyle()
+}
+
+func newHighContrastStyle() Style {
+	st := newStyle()
+	st.Body = Body{
+		FgColor:   "white",
+		BgColor:   "black",
+		LogoColor: "white",
+	}
+	st.Info = Info{
+		SectionColor: "yellow",
+		FgColor:      "white",
+	}
+	st.Frame = Frame{
+		Title: Title{
+			FgColor:        "white",
+			BgColor:        "black",
+			HighlightColor: "yellow",
+			CounterColor:   "white",
+			FilterColor:    "yellow",
+		},
+		Border: Border{
+			FgColor:    "white",
+			FocusColor: "yellow",
+		},
+		Menu: Menu{
+			FgColor:     "white",
+			KeyColor:    "yellow",
+			NumKeyColor: "yellow",
+		},
+		Crumb: Crumb{
+			FgColor:     "black",
+			BgColor:     "white",
+			ActiveColor: "yellow",
+		},
+		Status: Status{
+			NewColor:       "white",
+			ModifyColor:    "aqua",
+			AddColor:       "lightskyblue",
+			PendingColor:   "yellow",
+			ErrorColor:     "fuchsia",
+			HighlightColor: "gold",
+			KillColor:      "silver",
+			CompletedColor: "gray",
+		},
+	}
+	st.Dialog = Dialog{
+		FgColor:            "white",
+		BgColor:            "black",
+		ButtonBgColor:      "white",
+		ButtonFgColor:      "black",
+		ButtonFocusBgColor: "yellow",
+		ButtonFocusFgColor: "black",
+		LabelFgColor:       "yellow",
+		FieldFgColor:       "white",
+	}
+	st.Views.Table = Table{
+		FgColor:       "white",
+		BgColor:       "black",
+		CursorFgColor: "black",
+		CursorBgColor: "yellow",
+		MarkColor:     "aqua",
+		Header: TableHeader{
+			FgColor:     "yellow",
+			BgColor:     "black",
+			SorterColor: "white",
+		},
+	}
+	st.Views.Xray = Xray{
+		FgColor:         "white",
+		BgColor:         "black",
+		CursorColor:     "yellow",
+		CursorTextColor: "black",
+		GraphicColor:    "white",
+	}
+	st.Views.Yaml = Yaml{
+		KeyColor:   "yellow",
+		ColonColor: "white",
+		ValueColor: "white",
+	}
+	st.Views.Log = Log{
+		FgColor: "white",
+		BgColor: "black",
+		Indicator: LogIndicator{
+			FgColor: "yellow",
+			BgColor: "black",
+		},
+	}
+	st.Views.Charts.DefaultDialColors = Colors{Color("white"), Color("yellow")}
+	st.Views.Charts.DefaultChartColors = Colors{Color("white"), Color("yellow")}
+	st.Views.Charts.ResourceColors = map[string]Colors{
+		"cpu": {Color("white"), Color("yellow")},
+		"mem": {Color("white"), Color("yellow")},
+	}
+
+	return st
+}
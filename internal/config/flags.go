@@ -21,6 +21,8 @@ type Flags struct {
 	ReadOnly      *bool
 	Write         *bool
 	Crumbsless    *bool
+	Accessible    *bool
+	FromSnapshot  *string
 }
 
 // NewFlags returns new configuration flags.
@@ -34,6 +36,8 @@ func NewFlags() *Flags {
 		ReadOnly:      boolPtr(false),
 		Write:         boolPtr(false),
 		Crumbsless:    boolPtr(false),
+		Accessible:    boolPtr(false),
+		FromSnapshot:  strPtr(""),
 	}
 }
 
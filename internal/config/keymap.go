@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// AllScope represents key bindings available to all views.
+const AllScope = "all"
+
+// K9sKeyMaps manages K9s key bindings overrides.
+var K9sKeyMaps = filepath.Join(OscHome(), "keymap.yml")
+
+// KeyMaps represents a collection of per scope key bindings.
+// Each scope maps an action name ie `Shell` to a shortcut ie `Shift-S`.
+type KeyMaps struct {
+	KeyMap map[string]KeyMap `yaml:"keyMap"`
+}
+
+// KeyMap maps action names to shortcuts.
+type KeyMap map[string]string
+
+// NewKeyMaps returns a new key maps.
+func NewKeyMaps() KeyMaps {
+	return KeyMaps{
+		KeyMap: make(map[string]KeyMap),
+	}
+}
+
+// Load K9s key maps.
+func (k KeyMaps) Load() error {
+	return k.LoadKeyMaps(K9sKeyMaps)
+}
+
+// LoadKeyMaps loads key maps from a given file.
+func (k KeyMaps) LoadKeyMaps(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var kk KeyMaps
+	if err := yaml.Unmarshal(f, &kk); err != nil {
+		return err
+	}
+	for s, m := range kk.KeyMap {
+		k.KeyMap[s] = m
+	}
+
+	return nil
+}
+
+// Validate checks for shortcuts bound to several actions within a scope
+// or clashing with the all scope. Conflicting bindings are dropped.
+func (k KeyMaps) Validate() error {
+	var errs []string
+	for _, s := range k.scopes() {
+		for sc, aa := range k.shortcuts(s) {
+			if len(aa) < 2 {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("%s: %q bound to %s", s, sc, strings.Join(aa, ", ")))
+			for _, a := range aa {
+				delete(k.KeyMap[s], a)
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Strings(errs)
+
+	return fmt.Errorf("keymap conflicts: %s", strings.Join(errs, "; "))
+}
+
+// BindingsFor returns the action bindings for the given scopes.
+// Scoped bindings override the ones in the all scope.
+func (k KeyMaps) BindingsFor(scopes []string) KeyMap {
+	m := make(KeyMap)
+	for a, sc := range k.KeyMap[AllScope] {
+		m[a] = sc
+	}
+	for _, s := range scopes {
+		if s == AllScope {
+			continue
+		}
+		for a, sc := range k.KeyMap[s] {
+			m[a] = sc
+		}
+	}
+
+	return m
+}
+
+// scopes returns all scopes sorted with the all scope first, so its clashes with
+// specific scopes are dropped on the specific side.
+func (k KeyMaps) scopes() []string {
+	ss := make([]string, 0, len(k.KeyMap))
+	for s := range k.KeyMap {
+		if s != AllScope {
+			ss = append(ss, s)
+		}
+	}
+	sort.Strings(ss)
+	if _, ok := k.KeyMap[AllScope]; ok {
+		ss = append([]string{AllScope}, ss...)
+	}
+
+	return ss
+}
+
+// shortcuts inverts a scope bindings merged with the all scope.
+func (k KeyMaps) shortcuts(scope string) map[string][]string {
+	mm := make(map[string][]string)
+	for a, sc := range k.BindingsFor([]string{scope}) {
+		key := strings.ToLower(sc)
+		mm[key] = append(mm[key], a)
+	}
+	for _, aa := range mm {
+		sort.Strings(aa)
+	}
+
+	return mm
+}
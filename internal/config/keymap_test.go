@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapLoad(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.Nil(t, k.LoadKeyMaps("testdata/keymap.yml"))
+
+	assert.Equal(t, 3, len(k.KeyMap))
+	assert.Equal(t, "Shift-S", k.KeyMap["pods"]["Shell"])
+	assert.Equal(t, "Shift-K", k.KeyMap[config.AllScope]["Kill"])
+}
+
+func TestKeyMapValidate(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.Nil(t, k.LoadKeyMaps("testdata/keymap.yml"))
+
+	err := k.Validate()
+	assert.EqualError(t, err, `keymap conflicts: logs: "shift-w" bound to Toggle Timestamp, Toggle Wrap; pods: "shift-d" bound to Describe, Logs`)
+	assert.Equal(t, config.KeyMap{}, k.KeyMap["logs"])
+	assert.Equal(t, config.KeyMap{"Shell": "Shift-S", "Sort CPU": "Shift-X"}, k.KeyMap["pods"])
+	assert.Nil(t, k.Validate())
+}
+
+func TestKeyMapBindingsFor(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.Nil(t, k.LoadKeyMaps("testdata/keymap.yml"))
+	assert.NotNil(t, k.Validate())
+
+	uu := map[string]struct {
+		scopes []string
+		e      config.KeyMap
+	}{
+		"all": {
+			scopes: []string{"deployments", "deploy", "dp"},
+			e:      config.KeyMap{"Kill": "Shift-K", "Describe": "Shift-D"},
+		},
+		"scoped": {
+			scopes: []string{"po", "pod", "pods"},
+			e:      config.KeyMap{"Kill": "Shift-K", "Describe": "Shift-D", "Shell": "Shift-S", "Sort CPU": "Shift-X"},
+		},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.BindingsFor(u.scopes))
+		})
+	}
+}
@@ -18,15 +18,18 @@ type Osc struct {
 	Crumbsless        bool                `yaml:"crumbsless"`
 	ReadOnly          bool                `yaml:"readOnly"`
 	NoIcons           bool                `yaml:"noIcons"`
+	Accessible        bool                `yaml:"accessible"`
 	Logger            *Logger             `yaml:"logger"`
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
 	Thresholds        Threshold           `yaml:"thresholds"`
+	CertExpiry        *CertExpiry         `yaml:"certExpiry"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualCrumbsless  *bool
 	manualReadOnly    *bool
+	manualAccessible  *bool
 	manualCommand     *string
 }
 
@@ -38,6 +41,7 @@ func NewOsc() *Osc {
 		Logger:       NewLogger(),
 		Clusters:     make(map[string]*Cluster),
 		Thresholds:   NewThreshold(),
+		CertExpiry:   NewCertExpiry(),
 	}
 }
 
@@ -56,6 +60,11 @@ func (k *Osc) OverrideCrumbsless(b bool) {
 	k.manualCrumbsless = &b
 }
 
+// OverrideAccessible set the accessibility mode manually.
+func (k *Osc) OverrideAccessible(b bool) {
+	k.manualAccessible = &b
+}
+
 // OverrideReadOnly set the readonly mode manually.
 func (k *Osc) OverrideReadOnly(b bool) {
 	if b {
@@ -96,6 +105,21 @@ func (k *Osc) IsCrumbsless() bool {
 	return h
 }
 
+// IsAccessible returns accessibility mode setting.
+func (k *Osc) IsAccessible() bool {
+	a := k.Accessible
+	if k.manualAccessible != nil && *k.manualAccessible {
+		a = *k.manualAccessible
+	}
+
+	return a
+}
+
+// IsNoIcons returns true if icons should not be displayed.
+func (k *Osc) IsNoIcons() bool {
+	return k.NoIcons || k.IsAccessible()
+}
+
 // GetRefreshRate returns the current refresh rate.
 func (k *Osc) GetRefreshRate() int {
 	rate := k.RefreshRate
@@ -173,6 +197,10 @@ func (k *Osc) Validate(c client.Connection, ks KubeSettings) {
 		k.Thresholds = NewThreshold()
 	}
 	k.Thresholds.Validate(c, ks)
+	if k.CertExpiry == nil {
+		k.CertExpiry = NewCertExpiry()
+	}
+	k.CertExpiry.Validate()
 
 	if ctx, err := ks.CurrentContextName(); err == nil && len(k.CurrentContext) == 0 {
 		k.CurrentContext = ctx
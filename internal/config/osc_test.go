@@ -59,6 +59,28 @@ func TestIsReadOnly(t *testing.T) {
 	}
 }
 
+func TestIsAccessible(t *testing.T) {
+	uu := map[string]struct {
+		accessible, override bool
+		e, noIcons           bool
+	}{
+		"off":      {},
+		"config":   {accessible: true, e: true, noIcons: true},
+		"override": {override: true, e: true, noIcons: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewOsc()
+			c.Accessible = u.accessible
+			c.OverrideAccessible(u.override)
+			assert.Equal(t, u.e, c.IsAccessible())
+			assert.Equal(t, u.noIcons, c.IsNoIcons())
+		})
+	}
+}
+
 func TestK9sValidate(t *testing.T) {
 	mc := NewMockConnection()
 	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
@@ -10,6 +10,14 @@ import (
 // K9sPlugins manages K9s plugins.
 var K9sPlugins = filepath.Join(OscHome(), "plugin.yml")
 
+const (
+	// PluginOutputTable renders a plugin JSON output in a table view.
+	PluginOutputTable = "table"
+
+	// PluginOutputDetails renders a plugin output in a details view.
+	PluginOutputDetails = "details"
+)
+
 // Plugins represents a collection of plugins.
 type Plugins struct {
 	Plugin map[string]Plugin `yaml:"plugin"`
@@ -24,6 +32,13 @@ type Plugin struct {
 	Command     string   `yaml:"command"`
 	Confirm     bool     `yaml:"confirm"`
 	Background  bool     `yaml:"background"`
+	Output      string   `yaml:"output"`
+	RefreshRate int      `yaml:"refreshRate"`
+}
+
+// IsRendered returns true if the plugin output is rendered in the UI.
+func (p Plugin) IsRendered() bool {
+	return p.Output == PluginOutputTable || p.Output == PluginOutputDetails
 }
 
 // NewPlugins returns a new plugin.
@@ -11,7 +11,7 @@ func TestPluginLoad(t *testing.T) {
 	p := config.NewPlugins()
 	assert.Nil(t, p.LoadPlugins("testdata/plugin.yml"))
 
-	assert.Equal(t, 1, len(p.Plugin))
+	assert.Equal(t, 2, len(p.Plugin))
 	k, ok := p.Plugin["blah"]
 	assert.True(t, ok)
 	assert.Equal(t, "shift-s", k.ShortCut)
@@ -21,4 +21,11 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, "duh", k.Command)
 	assert.False(t, k.Background)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
+	assert.False(t, k.IsRendered())
+
+	k, ok = p.Plugin["zorg"]
+	assert.True(t, ok)
+	assert.Equal(t, config.PluginOutputTable, k.Output)
+	assert.Equal(t, 5, k.RefreshRate)
+	assert.True(t, k.IsRendered())
 }
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Row color rule operators.
+const (
+	OpMatches    = "matches"
+	OpNotMatches = "!matches"
+	OpEq         = "=="
+	OpNeq        = "!="
+	OpGt         = ">"
+	OpGte        = ">="
+	OpLt         = "<"
+	OpLte        = "<="
+)
+
+// RowColor tracks a conditional row color rule ie color a row when a column
+// value satisfies a given condition.
+type RowColor struct {
+	Column string `yaml:"column"`
+	Op     string `yaml:"op"`
+	Value  string `yaml:"value"`
+	Color  Color  `yaml:"color"`
+
+	rx *regexp.Regexp
+}
+
+// Validate checks the rule is well formed.
+func (r *RowColor) Validate() error {
+	if r.Column == "" {
+		return fmt.Errorf("row color rule is missing a column")
+	}
+	if r.Color == "" {
+		return fmt.Errorf("row color rule on %q is missing a color", r.Column)
+	}
+	r.Column = strings.ToUpper(r.Column)
+	switch r.Op {
+	case "", OpMatches, OpNotMatches:
+		rx, err := regexp.Compile(r.Value)
+		if err != nil {
+			return fmt.Errorf("invalid row color rule regex %q: %s", r.Value, err)
+		}
+		r.rx = rx
+	case OpEq, OpNeq:
+	case OpGt, OpGte, OpLt, OpLte:
+		if _, ok := asNumber(r.Value); !ok {
+			return fmt.Errorf("row color rule on %q expects a number but got %q", r.Column, r.Value)
+		}
+	default:
+		return fmt.Errorf("invalid row color rule operator %q", r.Op)
+	}
+
+	return nil
+}
+
+// Matches returns true if the given column value satisfies the rule.
+func (r *RowColor) Matches(v string) bool {
+	v = strings.TrimSpace(v)
+	switch r.Op {
+	case "", OpMatches, OpNotMatches:
+		if r.rx == nil {
+			return false
+		}
+		return r.rx.MatchString(v) == (r.Op != OpNotMatches)
+	case OpEq:
+		return v == r.Value
+	case OpNeq:
+		return v != r.Value
+	}
+
+	n, ok := asNumber(v)
+	if !ok {
+		return false
+	}
+	ref, _ := asNumber(r.Value)
+	switch r.Op {
+	case OpGt:
+		return n > ref
+	case OpGte:
+		return n >= ref
+	case OpLt:
+		return n < ref
+	case OpLte:
+		return n <= ref
+	default:
+		return false
+	}
+}
+
+func asNumber(s string) (float64, bool) {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, true
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(q.MilliValue()) / 1000, true
+}
@@ -0,0 +1,65 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowColorValidate(t *testing.T) {
+	uu := map[string]struct {
+		r   config.RowColor
+		err bool
+	}{
+		"regex":      {r: config.RowColor{Column: "status", Value: "Evicted", Color: "gray"}},
+		"numeric":    {r: config.RowColor{Column: "RESTARTS", Op: config.OpGt, Value: "5", Color: "red"}},
+		"quantity":   {r: config.RowColor{Column: "MEM", Op: config.OpGte, Value: "1Gi", Color: "red"}},
+		"no-column":  {r: config.RowColor{Value: "Evicted", Color: "gray"}, err: true},
+		"no-color":   {r: config.RowColor{Column: "STATUS", Value: "Evicted"}, err: true},
+		"bad-regex":  {r: config.RowColor{Column: "STATUS", Value: "(", Color: "gray"}, err: true},
+		"bad-op":     {r: config.RowColor{Column: "STATUS", Op: "~=", Value: "Evicted", Color: "gray"}, err: true},
+		"bad-number": {r: config.RowColor{Column: "RESTARTS", Op: config.OpLt, Value: "fred", Color: "red"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.r.Validate()
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestRowColorMatches(t *testing.T) {
+	uu := map[string]struct {
+		r config.RowColor
+		v string
+		e bool
+	}{
+		"match":        {r: config.RowColor{Value: "Evicted|Error"}, v: "Evicted", e: true},
+		"no-match":     {r: config.RowColor{Value: "Evicted|Error"}, v: "Running"},
+		"not-matches":  {r: config.RowColor{Op: config.OpNotMatches, Value: "Running"}, v: "Pending", e: true},
+		"eq":           {r: config.RowColor{Op: config.OpEq, Value: "1/1"}, v: " 1/1 ", e: true},
+		"neq":          {r: config.RowColor{Op: config.OpNeq, Value: "1/1"}, v: "0/1", e: true},
+		"gt":           {r: config.RowColor{Op: config.OpGt, Value: "5"}, v: "6", e: true},
+		"gt-equal":     {r: config.RowColor{Op: config.OpGt, Value: "5"}, v: "5"},
+		"gte":          {r: config.RowColor{Op: config.OpGte, Value: "5"}, v: "5", e: true},
+		"lt":           {r: config.RowColor{Op: config.OpLt, Value: "1Gi"}, v: "512Mi", e: true},
+		"lte-quantity": {r: config.RowColor{Op: config.OpLte, Value: "100m"}, v: "0.2"},
+		"not-number":   {r: config.RowColor{Op: config.OpGt, Value: "5"}, v: "n/a"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.r.Column, u.r.Color = "FRED", "red"
+			assert.Nil(t, u.r.Validate())
+			assert.Equal(t, u.e, u.r.Matches(u.v))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+const (
+	// SplitHorizontal lays out panes side by side.
+	SplitHorizontal = "horizontal"
+
+	// SplitVertical stacks panes on top of each other.
+	SplitVertical = "vertical"
+
+	// SplitPanes represents the number of panes in a split layout.
+	SplitPanes = 2
+)
+
+// Split tracks a split panes layout. Each pane is a view command.
+type Split struct {
+	Orientation string   `yaml:"orientation"`
+	Panes       []string `yaml:"panes"`
+}
+
+// IsValid returns true if the layout can be displayed.
+func (s *Split) IsValid() bool {
+	return s != nil && len(s.Panes) == SplitPanes
+}
+
+// IsVertical returns true if panes are stacked.
+func (s *Split) IsVertical() bool {
+	return s.Orientation == SplitVertical
+}
+
+// Validate a split layout.
+func (s *Split) Validate() {
+	if s.Orientation != SplitVertical {
+		s.Orientation = SplitHorizontal
+	}
+}
@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitValidate(t *testing.T) {
+	uu := map[string]struct {
+		s        config.Split
+		valid    bool
+		vertical bool
+	}{
+		"horizontal": {
+			s:     config.Split{Panes: []string{"dp", "events"}},
+			valid: true,
+		},
+		"vertical": {
+			s:        config.Split{Orientation: config.SplitVertical, Panes: []string{"dp", "events"}},
+			valid:    true,
+			vertical: true,
+		},
+		"toast": {
+			s:     config.Split{Orientation: "diagonal", Panes: []string{"dp", "events"}},
+			valid: true,
+		},
+		"one-pane": {
+			s: config.Split{Panes: []string{"dp"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate()
+			assert.Equal(t, u.valid, u.s.IsValid())
+			assert.Equal(t, u.vertical, u.s.IsVertical())
+		})
+	}
+}
+
+func TestConfigActiveSplit(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	assert.Nil(t, cfg.ActiveSplit())
+
+	s := config.Split{Orientation: config.SplitVertical, Panes: []string{"dp", "po"}}
+	cfg.SetActiveSplit(&s)
+	assert.Equal(t, &s, cfg.ActiveSplit())
+}
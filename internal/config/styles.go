@@ -1,14 +1,20 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 )
 
+// maxSkinDepth represents the max number of skins inheritance levels.
+const maxSkinDepth = 5
+
 // K9sStylesFile represents K9s skins file location.
 var K9sStylesFile = filepath.Join(OscHome(), "skin.yml")
 
@@ -27,8 +33,10 @@ type (
 
 	// Styles tracks K9s styling options.
 	Styles struct {
-		K9s       Style `yaml:"k9s"`
+		Extends   string `yaml:"extends,omitempty"`
+		K9s       Style  `yaml:"k9s"`
 		listeners []StyleListener
+		files     []string
 	}
 
 	// Style tracks K9s styles.
@@ -70,11 +78,12 @@ type (
 
 	// Views tracks individual view styles.
 	Views struct {
-		Table  Table  `yaml:"table"`
-		Xray   Xray   `yaml:"xray"`
-		Charts Charts `yaml:"charts"`
-		Yaml   Yaml   `yaml:"yaml"`
-		Log    Log    `yaml:"logs"`
+		Table  Table            `yaml:"table"`
+		Tables map[string]Table `yaml:"tables,omitempty"`
+		Xray   Xray             `yaml:"xray"`
+		Charts Charts           `yaml:"charts"`
+		Yaml   Yaml             `yaml:"yaml"`
+		Log    Log              `yaml:"logs"`
 	}
 
 	// Status tracks resource status styles.
@@ -145,6 +154,7 @@ type (
 		CursorBgColor Color       `yaml:"cursorBgColor"`
 		MarkColor     Color       `yaml:"markColor"`
 		Header        TableHeader `yaml:"header"`
+		RowColors     []RowColor  `yaml:"rowColors,omitempty"`
 	}
 
 	// TableHeader tracks table header styles.
@@ -398,7 +408,7 @@ func NewStyles() *Styles {
 
 // Reset resets styles.
 func (s *Styles) Reset() {
-	s.K9s = newStyle()
+	s.Extends, s.K9s = "", newStyle()
 }
 
 // DefaultSkin loads the default skin
@@ -489,19 +499,121 @@ func (s *Styles) Views() Views {
 
 // Load K9s configuration from file
 func (s *Styles) Load(path string) error {
+	s.files = nil
+	if err := s.load(path, 0); err != nil {
+		return err
+	}
+	s.validateRowColors()
+	s.fireStylesChanged()
+
+	return nil
+}
+
+// Files returns the skin files loaded, base skins first.
+func (s *Styles) Files() []string {
+	return s.files
+}
+
+// TableFor returns the table styles for a given resource. Per resource
+// overrides are keyed by GVR or resource name.
+func (s *Styles) TableFor(gvr string) Table {
+	t := s.K9s.Views.Table
+	o, ok := s.K9s.Views.Tables[gvr]
+	if !ok {
+		o, ok = s.K9s.Views.Tables[client.NewGVR(gvr).R()]
+	}
+	if !ok {
+		return t
+	}
+
+	return t.merge(o)
+}
+
+// load loads a skin after its base skins if any.
+func (s *Styles) load(path string, depth int) error {
+	if depth > maxSkinDepth {
+		return fmt.Errorf("skin %q exceeds max inheritance depth of %d", path, maxSkinDepth)
+	}
 	f, err := ioutil.ReadFile(path)
 	if err != nil {
 		return err
 	}
 
+	var base struct {
+		Extends string `yaml:"extends"`
+	}
+	if err := yaml.Unmarshal(f, &base); err != nil {
+		return err
+	}
+	if base.Extends != "" {
+		if err := s.load(skinPath(path, base.Extends), depth+1); err != nil {
+			return err
+		}
+	}
 	if err := yaml.Unmarshal(f, s); err != nil {
 		return err
 	}
-	s.fireStylesChanged()
+	s.files = append(s.files, path)
 
 	return nil
 }
 
+func (s *Styles) validateRowColors() {
+	s.K9s.Views.Table.RowColors = validRowColors(s.K9s.Views.Table.RowColors)
+	for k, t := range s.K9s.Views.Tables {
+		t.RowColors = validRowColors(t.RowColors)
+		s.K9s.Views.Tables[k] = t
+	}
+}
+
+func validRowColors(rr []RowColor) []RowColor {
+	vv := make([]RowColor, 0, len(rr))
+	for _, r := range rr {
+		if err := r.Validate(); err != nil {
+			log.Warn().Err(err).Msg("Skipping row color rule")
+			continue
+		}
+		vv = append(vv, r)
+	}
+
+	return vv
+}
+
+// skinPath resolves a base skin location relative to the extending skin.
+func skinPath(from, name string) string {
+	if filepath.Ext(name) == "" {
+		name += ".yml"
+	}
+	if filepath.IsAbs(name) {
+		return name
+	}
+
+	return filepath.Join(filepath.Dir(from), name)
+}
+
+// merge overrides table styles with the set colors of another table.
+// Override rules are evaluated first.
+func (t Table) merge(o Table) Table {
+	mergeColor(&t.FgColor, o.FgColor)
+	mergeColor(&t.BgColor, o.BgColor)
+	mergeColor(&t.CursorFgColor, o.CursorFgColor)
+	mergeColor(&t.CursorBgColor, o.CursorBgColor)
+	mergeColor(&t.MarkColor, o.MarkColor)
+	mergeColor(&t.Header.FgColor, o.Header.FgColor)
+	mergeColor(&t.Header.BgColor, o.Header.BgColor)
+	mergeColor(&t.Header.SorterColor, o.Header.SorterColor)
+	rr := make([]RowColor, 0, len(o.RowColors)+len(t.RowColors))
+	t.RowColors = append(append(rr, o.RowColors...), t.RowColors...)
+
+	return t
+}
+
+func mergeColor(c *Color, o Color) {
+	if o != "" {
+		*c = o
+	}
+}
+
 // Update apply terminal colors based on styles.
 func (s *Styles) Update() {
 	tview.Styles.PrimitiveBackgroundColor = s.BgColor()
@@ -62,3 +62,39 @@ func TestSkinBoarked(t *testing.T) {
 	s := config.NewStyles()
 	assert.NotNil(t, s.Load("testdata/skin_boarked.yml"))
 }
+
+func TestSkinExtends(t *testing.T) {
+	s := config.NewStyles()
+	assert.Nil(t, s.Load("testdata/extended_skin.yml"))
+
+	assert.Equal(t, []string{"testdata/base_skin.yml", "testdata/extended_skin.yml"}, s.Files())
+	assert.Equal(t, "orange", s.Body().FgColor.String())
+	assert.Equal(t, "black", s.Body().BgColor.String())
+	assert.Equal(t, "yellow", s.Table().MarkColor.String())
+	assert.Equal(t, 1, len(s.Table().RowColors))
+}
+
+func TestSkinExtendsCycle(t *testing.T) {
+	s := config.NewStyles()
+	assert.Error(t, s.Load("testdata/cyclic_skin.yml"))
+}
+
+func TestSkinTableFor(t *testing.T) {
+	s := config.NewStyles()
+	assert.Nil(t, s.Load("testdata/extended_skin.yml"))
+
+	po := s.TableFor("v1/pods")
+	assert.Equal(t, "red", po.MarkColor.String())
+	assert.Equal(t, "white", po.FgColor.String())
+	assert.Equal(t, 2, len(po.RowColors))
+	assert.Equal(t, "RESTARTS", po.RowColors[0].Column)
+	assert.True(t, po.RowColors[0].Matches("6"))
+	assert.Equal(t, "Evicted|Completed", po.RowColors[1].Value, "invalid rules are skipped")
+
+	dp := s.TableFor("apps/v1/deployments")
+	assert.Equal(t, "blue", dp.FgColor.String())
+	assert.Equal(t, "yellow", dp.MarkColor.String())
+
+	svc := s.TableFor("v1/services")
+	assert.Equal(t, s.Table(), svc)
+}
@@ -0,0 +1,106 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*NodeAlloc)(nil)
+	_ Accessor = (*PodAlloc)(nil)
+)
+
+// NodeAlloc represents a node allocation breakdown.
+type NodeAlloc struct {
+	NonResource
+}
+
+// List returns a collection of node allocations.
+func (n *NodeAlloc) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	nn, err := FetchNodes(ctx, n.Factory, "")
+	if err != nil {
+		return nil, err
+	}
+	pods, err := n.activePods()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(nn.Items))
+	for i := range nn.Items {
+		no := nn.Items[i]
+		res = append(res, render.NodeAllocRes{Node: &no, Pods: pods[no.Name]})
+	}
+
+	return res, nil
+}
+
+// activePods returns all non terminated pods keyed by node name.
+func (n *NodeAlloc) activePods() (map[string][]*v1.Pod, error) {
+	oo, err := n.Factory.List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string][]*v1.Pod)
+	for _, o := range oo {
+		po := new(v1.Pod)
+		if err := fromUnstructured(o, po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName == "" || isTerminated(po) {
+			continue
+		}
+		pods[po.Spec.NodeName] = append(pods[po.Spec.NodeName], po)
+	}
+
+	return pods, nil
+}
+
+// PodAlloc represents the pods allocation on a given node.
+type PodAlloc struct {
+	NonResource
+}
+
+// List returns a collection of pod allocations for a node.
+func (p *PodAlloc) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", p.gvr)
+	}
+	no, err := FetchNode(ctx, p.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var nd Node
+	nd.Init(p.Factory, client.NewGVR("v1/nodes"))
+	pp, err := nd.GetPods(no.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(pp))
+	for _, po := range pp {
+		if isTerminated(po) {
+			continue
+		}
+		res = append(res, render.PodAllocRes{Pod: po, Allocatable: no.Status.Allocatable})
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isTerminated(po *v1.Pod) bool {
+	return po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed
+}
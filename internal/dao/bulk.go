@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"sync"
+)
+
+// BulkWorkers represents the max number of concurrent bulk operations.
+const BulkWorkers = 5
+
+// BulkFunc represents an operation on a given resource.
+type BulkFunc func(ctx context.Context, path string) error
+
+// BulkResult tracks a bulk operation outcome for a given resource.
+type BulkResult struct {
+	Path string
+	Err  error
+}
+
+// BulkRun runs an operation on all given resources using a bounded pool of workers.
+// Results are returned in the paths order.
+func BulkRun(ctx context.Context, paths []string, workers int, f BulkFunc) []BulkResult {
+	if workers <= 0 {
+		workers = BulkWorkers
+	}
+	rr := make([]BulkResult, len(paths))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(paths); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				rr[i] = BulkResult{Path: paths[i], Err: f(ctx, paths[i])}
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return rr
+}
@@ -0,0 +1,50 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkRun(t *testing.T) {
+	var running, max int32
+	f := func(ctx context.Context, path string) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		if path == "default/blee" {
+			return errors.New("boom")
+		}
+		return nil
+	}
+
+	paths := []string{"default/fred", "default/blee", "default/zorg", "default/duh", "default/bozo"}
+	rr := dao.BulkRun(context.Background(), paths, 2, f)
+
+	assert.Equal(t, len(paths), len(rr))
+	for i, r := range rr {
+		assert.Equal(t, paths[i], r.Path)
+	}
+	assert.EqualError(t, rr[1].Err, "boom")
+	assert.Nil(t, rr[0].Err)
+	assert.True(t, atomic.LoadInt32(&max) <= 2)
+}
+
+func TestBulkRunEmpty(t *testing.T) {
+	rr := dao.BulkRun(context.Background(), nil, 0, func(context.Context, string) error {
+		return nil
+	})
+
+	assert.Equal(t, 0, len(rr))
+}
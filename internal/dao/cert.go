@@ -0,0 +1,159 @@
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Cert)(nil)
+
+// Cert represents TLS secrets certificates.
+type Cert struct {
+	NonResource
+}
+
+// List returns the certificates of all TLS secrets including the ones referenced by ingresses.
+func (c *Cert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	refs, err := c.ingressRefs(ns)
+	if err != nil {
+		log.Warn().Err(err).Msgf("unable to list ingresses TLS references")
+	}
+
+	oo, err := c.Factory.List("v1/secrets", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	seen := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		var sec v1.Secret
+		if err := fromUnstructured(o, &sec); err != nil {
+			return nil, err
+		}
+		fqn := client.MetaFQN(sec.ObjectMeta)
+		if sec.Type != v1.SecretTypeTLS {
+			if _, ok := refs[fqn]; !ok {
+				continue
+			}
+		}
+		seen[fqn] = struct{}{}
+		res = append(res, secretCerts(&sec, refs[fqn])...)
+	}
+
+	for _, fqn := range sortedRefs(refs) {
+		if _, ok := seen[fqn]; ok {
+			continue
+		}
+		sns, n := client.Namespaced(fqn)
+		res = append(res, render.CertRes{
+			Namespace: sns,
+			Secret:    n,
+			Ingresses: refs[fqn],
+			Err:       errors.New("secret not found"),
+		})
+	}
+
+	return res, nil
+}
+
+// ingressRefs maps TLS secrets to the ingresses referencing them.
+func (c *Cert) ingressRefs(ns string) (map[string][]string, error) {
+	refs := make(map[string][]string)
+	oo, err := c.Factory.List("extensions/v1beta1/ingresses", ns, false, labels.Everything())
+	if err != nil {
+		return refs, err
+	}
+	for _, o := range oo {
+		var ing v1beta1.Ingress
+		if err := fromUnstructured(o, &ing); err != nil {
+			return refs, err
+		}
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName == "" {
+				continue
+			}
+			fqn := client.FQN(ing.Namespace, tls.SecretName)
+			refs[fqn] = append(refs[fqn], ing.Name)
+		}
+	}
+
+	return refs, nil
+}
+
+// ParseCertChain decodes all PEM encoded certificates from the given data.
+func ParseCertChain(data []byte) ([]*x509.Certificate, error) {
+	var cc []*x509.Certificate
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			break
+		}
+		if b.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, cert)
+	}
+	if len(cc) == 0 {
+		return nil, errors.New("no PEM certificate found")
+	}
+
+	return cc, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func secretCerts(sec *v1.Secret, ingresses []string) []runtime.Object {
+	res := render.CertRes{
+		Namespace: sec.Namespace,
+		Secret:    sec.Name,
+		Ingresses: ingresses,
+		Created:   sec.CreationTimestamp,
+	}
+	data, ok := sec.Data[v1.TLSCertKey]
+	if !ok {
+		res.Err = fmt.Errorf("missing %s key", v1.TLSCertKey)
+		return []runtime.Object{res}
+	}
+	cc, err := ParseCertChain(data)
+	if err != nil {
+		res.Err = err
+		return []runtime.Object{res}
+	}
+
+	oo := make([]runtime.Object, 0, len(cc))
+	for i, cert := range cc {
+		r := res
+		r.Index, r.ChainLen, r.Cert = i, len(cc), cert
+		oo = append(oo, r)
+	}
+
+	return oo
+}
+
+func sortedRefs(refs map[string][]string) []string {
+	kk := make([]string, 0, len(refs))
+	for k := range refs {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCertChain(t *testing.T) {
+	leaf, ca := makePEMCert(t, "fred.io"), makePEMCert(t, "osc-ca")
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("blee")})
+
+	uu := map[string]struct {
+		data []byte
+		e    []string
+		err  bool
+	}{
+		"single": {
+			data: leaf,
+			e:    []string{"fred.io"},
+		},
+		"chain": {
+			data: append(append(append([]byte{}, leaf...), key...), ca...),
+			e:    []string{"fred.io", "osc-ca"},
+		},
+		"empty": {
+			err: true,
+		},
+		"garbage": {
+			data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("blee")}),
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := dao.ParseCertChain(u.data)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			nn := make([]string, 0, len(cc))
+			for _, c := range cc {
+				nn = append(nn, c.Subject.CommonName)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+// Helpers...
+
+func makePEMCert(t *testing.T, cn string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
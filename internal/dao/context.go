@@ -11,8 +11,9 @@ import (
 )
 
 var (
-	_ Accessor   = (*Context)(nil)
-	_ Switchable = (*Context)(nil)
+	_ Accessor         = (*Context)(nil)
+	_ Switchable       = (*Context)(nil)
+	_ KubeConfigurable = (*Context)(nil)
 )
 
 // Context represents a kubenetes context.
@@ -33,15 +34,20 @@ func (c *Context) Get(ctx context.Context, path string) (runtime.Object, error)
 	return &render.NamedContext{Name: path, Context: co}, nil
 }
 
-// List all Contexts on the current cluster.
+// List all Contexts on the current cluster. Contexts health is probed in the
+// background.
 func (c *Context) List(_ context.Context, _ string) ([]runtime.Object, error) {
-	ctxs, err := c.config().Contexts()
+	cfg, err := c.config().RawConfig()
 	if err != nil {
 		return nil, err
 	}
-	cc := make([]runtime.Object, 0, len(ctxs))
-	for k, v := range ctxs {
-		cc = append(cc, render.NewNamedContext(c.config(), k, v))
+	cc := make([]runtime.Object, 0, len(cfg.Contexts))
+	for k, v := range cfg.Contexts {
+		nc := render.NewNamedContext(c.config(), k, v)
+		if h, ok := contextProber.Health(cfg, k); ok {
+			nc.Health = &h
+		}
+		cc = append(cc, nc)
 	}
 
 	return cc, nil
@@ -74,3 +80,28 @@ func (c *Context) KubeUpdate(n string) error {
 		clientcmd.NewDefaultPathOptions(), config, true,
 	)
 }
+
+// Rename renames a context.
+func (c *Context) Rename(n, newName string) error {
+	if err := c.config().RenameContext(n, newName); err != nil {
+		return err
+	}
+	contextProber.Forget(n)
+
+	return nil
+}
+
+// Duplicate copies a context under a new name.
+func (c *Context) Duplicate(n, dupName string) error {
+	return c.config().DupContext(n, dupName)
+}
+
+// SetNamespace sets a context default namespace.
+func (c *Context) SetNamespace(n, ns string) error {
+	return c.config().SetContextNamespace(n, ns)
+}
+
+// Import merges a kubeconfig file into the current configuration.
+func (c *Context) Import(path string) ([]string, error) {
+	return c.config().MergeConfig(path)
+}
@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"sync"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	contextProbeTTL     = 2 * time.Minute
+	contextProbeTimeout = 5 * time.Second
+	maxContextProbes    = 5
+)
+
+var contextProber = NewContextProber(func(cfg clientcmdapi.Config, n string) client.ContextHealth {
+	return client.ProbeContext(cfg, n, contextProbeTimeout)
+}, contextProbeTTL)
+
+// ProbeFunc checks a context health.
+type ProbeFunc func(cfg clientcmdapi.Config, n string) client.ContextHealth
+
+type contextProbe struct {
+	health client.ContextHealth
+	at     time.Time
+}
+
+// ContextProber probes contexts health in the background and caches the results.
+type ContextProber struct {
+	probe   ProbeFunc
+	ttl     time.Duration
+	health  map[string]contextProbe
+	pending map[string]struct{}
+	slots   chan struct{}
+	mx      sync.Mutex
+}
+
+// NewContextProber returns a new prober.
+func NewContextProber(probe ProbeFunc, ttl time.Duration) *ContextProber {
+	return &ContextProber{
+		probe:   probe,
+		ttl:     ttl,
+		health:  make(map[string]contextProbe),
+		pending: make(map[string]struct{}),
+		slots:   make(chan struct{}, maxContextProbes),
+	}
+}
+
+// Health returns a context last known health if any. A background probe is
+// scheduled if the context was never probed or its health is stale.
+func (p *ContextProber) Health(cfg clientcmdapi.Config, n string) (client.ContextHealth, bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	pr, ok := p.health[n]
+	if ok && time.Since(pr.at) < p.ttl {
+		return pr.health, true
+	}
+	if _, busy := p.pending[n]; !busy {
+		p.pending[n] = struct{}{}
+		go p.run(cfg, n)
+	}
+
+	return pr.health, ok
+}
+
+// Forget clears out a context health.
+func (p *ContextProber) Forget(n string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	delete(p.health, n)
+}
+
+func (p *ContextProber) run(cfg clientcmdapi.Config, n string) {
+	p.slots <- struct{}{}
+	h := p.probe(cfg, n)
+	<-p.slots
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.health[n] = contextProbe{health: h, at: time.Now()}
+	delete(p.pending, n)
+}
@@ -0,0 +1,51 @@
+package dao_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestContextProberHealth(t *testing.T) {
+	var count int32
+	p := dao.NewContextProber(func(_ clientcmdapi.Config, n string) client.ContextHealth {
+		atomic.AddInt32(&count, 1)
+		return client.ContextHealth{Reachable: true, AuthValid: true, Version: n}
+	}, time.Hour)
+
+	var cfg clientcmdapi.Config
+	_, ok := p.Health(cfg, "fred")
+	assert.False(t, ok)
+	assert.Eventually(t, func() bool {
+		_, ok := p.Health(cfg, "fred")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	h, _ := p.Health(cfg, "fred")
+	assert.Equal(t, "fred", h.Version)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	p.Forget("fred")
+	_, ok = p.Health(cfg, "fred")
+	assert.False(t, ok)
+}
+
+func TestContextProberStale(t *testing.T) {
+	var count int32
+	p := dao.NewContextProber(func(clientcmdapi.Config, string) client.ContextHealth {
+		atomic.AddInt32(&count, 1)
+		return client.ContextHealth{}
+	}, 0)
+
+	var cfg clientcmdapi.Config
+	p.Health(cfg, "fred")
+	assert.Eventually(t, func() bool {
+		_, ok := p.Health(cfg, "fred")
+		return ok && atomic.LoadInt32(&count) > 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -2,12 +2,18 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+
 var (
 	_ Accessor = (*CustomResourceDefinition)(nil)
 	_ Nuker    = (*CustomResourceDefinition)(nil)
@@ -18,6 +24,11 @@ type CustomResourceDefinition struct {
 	Resource
 }
 
+// CanWatch returns false as CRDs are listed from a fixed api version.
+func (c *CustomResourceDefinition) CanWatch() bool {
+	return false
+}
+
 // List returns a collection of nodes.
 func (c *CustomResourceDefinition) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
@@ -26,6 +37,166 @@ func (c *CustomResourceDefinition) List(ctx context.Context, _ string) ([]runtim
 		labelSel = sel.AsSelector()
 	}
 
-	const gvr = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
-	return c.Factory.List(gvr, "-", false, labelSel)
+	return c.Factory.List(crdGVR, "-", false, labelSel)
+}
+
+// Info returns a CRD versions and schemas.
+func (c *CustomResourceDefinition) Info(path string) (*CRDInfo, error) {
+	_, n := client.Namespaced(path)
+	o, err := c.Factory.Get(crdGVR, client.FQN(client.ClusterScope, n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return NewCRDInfo(u.Object)
+}
+
+// Instances returns the CRD instances count per namespace.
+func (c *CustomResourceDefinition) Instances(info *CRDInfo) (map[string]int, error) {
+	ns := client.AllNamespaces
+	if !info.Namespaced() {
+		ns = client.ClusterScope
+	}
+	oo, err := c.Factory.List(info.GVR().String(), ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		key := client.ClusterScope
+		if info.Namespaced() {
+			key = u.GetNamespace()
+		}
+		counts[key]++
+	}
+
+	return counts, nil
+}
+
+// ----------------------------------------------------------------------------
+// CRD Info...
+
+// CRDVersion represents a CRD api version.
+type CRDVersion struct {
+	Name            string
+	Served, Storage bool
+	Schema          *SchemaField
+}
+
+// CRDInfo represents a CRD names, scope and versions.
+type CRDInfo struct {
+	Name, Group  string
+	Kind, Plural string
+	Scope        string
+	Versions     []CRDVersion
+}
+
+// NewCRDInfo returns a CRD info from either a v1 or v1beta1 CRD.
+func NewCRDInfo(o map[string]interface{}) (*CRDInfo, error) {
+	var info CRDInfo
+	info.Name, _, _ = unstructured.NestedString(o, "metadata", "name")
+	info.Group, _, _ = unstructured.NestedString(o, "spec", "group")
+	info.Kind, _, _ = unstructured.NestedString(o, "spec", "names", "kind")
+	info.Plural, _, _ = unstructured.NestedString(o, "spec", "names", "plural")
+	info.Scope, _, _ = unstructured.NestedString(o, "spec", "scope")
+	if info.Group == "" || info.Plural == "" {
+		return nil, fmt.Errorf("invalid CRD %q: missing group or names", info.Name)
+	}
+
+	global := nestedMap(o, "spec", "validation", "openAPIV3Schema")
+	raw, _, _ := unstructured.NestedFieldNoCopy(o, "spec", "versions")
+	vv, _ := raw.([]interface{})
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var cv CRDVersion
+		cv.Name, _, _ = unstructured.NestedString(m, "name")
+		cv.Served, _, _ = unstructured.NestedBool(m, "served")
+		cv.Storage, _, _ = unstructured.NestedBool(m, "storage")
+		schema := nestedMap(m, "schema", "openAPIV3Schema")
+		if schema == nil {
+			schema = global
+		}
+		if schema != nil {
+			cv.Schema = NewSchemaField(info.Kind, schema)
+		}
+		info.Versions = append(info.Versions, cv)
+	}
+	if len(info.Versions) == 0 {
+		name, _, _ := unstructured.NestedString(o, "spec", "version")
+		if name == "" {
+			return nil, fmt.Errorf("invalid CRD %q: no versions found", info.Name)
+		}
+		cv := CRDVersion{Name: name, Served: true, Storage: true}
+		if global != nil {
+			cv.Schema = NewSchemaField(info.Kind, global)
+		}
+		info.Versions = append(info.Versions, cv)
+	}
+
+	return &info, nil
+}
+
+// Namespaced returns true if the CRD instances are namespaced.
+func (c *CRDInfo) Namespaced() bool {
+	return c.Scope != "Cluster"
+}
+
+// GVR returns the resource descriptor for the default version.
+func (c *CRDInfo) GVR() client.GVR {
+	v := c.Versions[c.DefaultVersion()].Name
+	return client.NewGVR(c.Group + "/" + v + "/" + c.Plural)
+}
+
+// DefaultVersion returns the index of the served storage version or the
+// first served version otherwise.
+func (c *CRDInfo) DefaultVersion() int {
+	served := -1
+	for i, v := range c.Versions {
+		if v.Served && v.Storage {
+			return i
+		}
+		if v.Served && served < 0 {
+			served = i
+		}
+	}
+	if served < 0 {
+		return 0
+	}
+
+	return served
+}
+
+// SortedCounts returns namespaces sorted by instances count.
+func SortedCounts(counts map[string]int) []string {
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		if counts[kk[i]] == counts[kk[j]] {
+			return kk[i] < kk[j]
+		}
+		return counts[kk[i]] > counts[kk[j]]
+	})
+
+	return kk
+}
+
+// nestedMap returns a nested map without copying it or nil if not found.
+func nestedMap(o map[string]interface{}, fields ...string) map[string]interface{} {
+	v, _, _ := unstructured.NestedFieldNoCopy(o, fields...)
+	m, _ := v.(map[string]interface{})
+
+	return m
 }
@@ -0,0 +1,113 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCRDInfo(t *testing.T) {
+	uu := map[string]struct {
+		o        map[string]interface{}
+		versions []string
+		gvr      string
+		schema   bool
+		err      bool
+	}{
+		"v1": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "foos.fred.io"},
+				"spec": map[string]interface{}{
+					"group": "fred.io",
+					"scope": "Namespaced",
+					"names": map[string]interface{}{"kind": "Foo", "plural": "foos"},
+					"versions": []interface{}{
+						map[string]interface{}{"name": "v1alpha1", "served": true},
+						map[string]interface{}{
+							"name":    "v1",
+							"served":  true,
+							"storage": true,
+							"schema":  map[string]interface{}{"openAPIV3Schema": fooSchema()},
+						},
+					},
+				},
+			},
+			versions: []string{"v1alpha1", "v1"},
+			gvr:      "fred.io/v1/foos",
+			schema:   true,
+		},
+		"v1beta1": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "foos.fred.io"},
+				"spec": map[string]interface{}{
+					"group":      "fred.io",
+					"scope":      "Cluster",
+					"version":    "v1beta1",
+					"names":      map[string]interface{}{"kind": "Foo", "plural": "foos"},
+					"validation": map[string]interface{}{"openAPIV3Schema": fooSchema()},
+				},
+			},
+			versions: []string{"v1beta1"},
+			gvr:      "fred.io/v1beta1/foos",
+			schema:   true,
+		},
+		"notServed": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "foos.fred.io"},
+				"spec": map[string]interface{}{
+					"group": "fred.io",
+					"names": map[string]interface{}{"kind": "Foo", "plural": "foos"},
+					"versions": []interface{}{
+						map[string]interface{}{"name": "v1", "storage": true},
+						map[string]interface{}{"name": "v2", "served": true},
+					},
+				},
+			},
+			versions: []string{"v1", "v2"},
+			gvr:      "fred.io/v2/foos",
+		},
+		"noVersions": {
+			o: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"group": "fred.io",
+					"names": map[string]interface{}{"kind": "Foo", "plural": "foos"},
+				},
+			},
+			err: true,
+		},
+		"noGroup": {
+			o:   map[string]interface{}{"spec": map[string]interface{}{}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			info, err := dao.NewCRDInfo(u.o)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			vv := make([]string, 0, len(info.Versions))
+			for _, v := range info.Versions {
+				vv = append(vv, v.Name)
+			}
+			assert.Equal(t, u.versions, vv)
+			assert.Equal(t, u.gvr, info.GVR().String())
+			assert.Equal(t, u.schema, info.Versions[info.DefaultVersion()].Schema != nil)
+		})
+	}
+}
+
+func TestCRDInfoNamespaced(t *testing.T) {
+	assert.True(t, (&dao.CRDInfo{Scope: "Namespaced"}).Namespaced())
+	assert.False(t, (&dao.CRDInfo{Scope: "Cluster"}).Namespaced())
+}
+
+func TestSortedCounts(t *testing.T) {
+	counts := map[string]int{"ns1": 2, "ns2": 5, "ns3": 2}
+
+	assert.Equal(t, []string{"ns2", "ns1", "ns3"}, dao.SortedCounts(counts))
+}
@@ -4,23 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/open-infra/osc/internal/client"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
 const maxJobNameSize = 42
 
 var (
-	_ Accessor = (*CronJob)(nil)
-	_ Runnable = (*CronJob)(nil)
+	_ Accessor          = (*CronJob)(nil)
+	_ Runnable          = (*CronJob)(nil)
+	_ OverridableRunner = (*CronJob)(nil)
+	_ Suspendable       = (*CronJob)(nil)
 )
 
 // CronJob represents a cronjob K8s resource.
@@ -28,8 +33,60 @@ type CronJob struct {
 	Generic
 }
 
+// JobOverrides tracks a manual job container overrides.
+type JobOverrides struct {
+	// Container represents the container to override. Defaults to the first container.
+	Container string
+
+	// Env represents environment variables to add or replace.
+	Env []v1.EnvVar
+
+	// Args replaces the container args when set.
+	Args []string
+}
+
+// IsEmpty returns true if no overrides are set.
+func (o JobOverrides) IsEmpty() bool {
+	return len(o.Env) == 0 && len(o.Args) == 0
+}
+
+// ParseJobOverrides parses `KEY=VALUE` env rows and space separated args.
+func ParseJobOverrides(container string, env []string, args string) (JobOverrides, error) {
+	oo := JobOverrides{Container: container, Args: strings.Fields(args)}
+	for _, e := range env {
+		tokens := strings.SplitN(e, "=", 2)
+		if len(tokens) != 2 || tokens[0] == "" {
+			return oo, fmt.Errorf("invalid env var %q. Expecting KEY=VALUE", e)
+		}
+		oo.Env = append(oo.Env, v1.EnvVar{Name: tokens[0], Value: tokens[1]})
+	}
+
+	return oo, nil
+}
+
+// Load returns a cronjob instance.
+func (*CronJob) Load(f Factory, fqn string) (*batchv1beta1.CronJob, error) {
+	o, err := f.Get("batch/v1beta1/cronjobs", fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cj batchv1beta1.CronJob
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cj)
+	if err != nil {
+		return nil, errors.New("expecting CronJob resource")
+	}
+
+	return &cj, nil
+}
+
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
+	return c.RunWith(path, JobOverrides{})
+}
+
+// RunWith runs a CronJob with the given container overrides.
+func (c *CronJob) RunWith(path string, oo JobOverrides) error {
 	ns, _ := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, "batch/v1/jobs", []string{client.GetVerb, client.CreateVerb})
 	if err != nil {
@@ -39,15 +96,10 @@ func (c *CronJob) Run(path string) error {
 		return fmt.Errorf("user is not authorized to run jobs")
 	}
 
-	o, err := c.Factory.Get("batch/v1beta1/cronjobs", path, true, labels.Everything())
+	cj, err := c.Load(c.Factory, path)
 	if err != nil {
 		return err
 	}
-	var cj batchv1beta1.CronJob
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cj)
-	if err != nil {
-		return errors.New("expecting CronJob resource")
-	}
 	var jobName = cj.Name
 	if len(cj.Name) >= maxJobNameSize {
 		jobName = cj.Name[0:maxJobNameSize]
@@ -68,7 +120,10 @@ func (c *CronJob) Run(path string) error {
 				},
 			},
 		},
-		Spec: cj.Spec.JobTemplate.Spec,
+		Spec: *cj.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+	if err := applyJobOverrides(&job.Spec.Template.Spec, oo); err != nil {
+		return err
 	}
 	dial, err := c.Client().Dial()
 	if err != nil {
@@ -81,6 +136,37 @@ func (c *CronJob) Run(path string) error {
 	return err
 }
 
+// ToggleSuspend suspends or resumes a CronJob.
+func (c *CronJob) ToggleSuspend(ctx context.Context, path string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{client.GetVerb, client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update cronjobs")
+	}
+
+	cj, err := c.Load(c.Factory, path)
+	if err != nil {
+		return err
+	}
+	suspend := cj.Spec.Suspend == nil || !*cj.Spec.Suspend
+	dial, err := c.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.BatchV1beta1().CronJobs(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
 // ScanSA scans for serviceaccount refs.
 func (c *CronJob) ScanSA(ctx context.Context, fqn string, wait bool) (Refs, error) {
 	ns, n := client.Namespaced(fqn)
@@ -149,3 +235,48 @@ func (c *CronJob) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs, e
 
 	return refs, nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func applyJobOverrides(spec *v1.PodSpec, oo JobOverrides) error {
+	if oo.IsEmpty() {
+		return nil
+	}
+	if len(spec.Containers) == 0 {
+		return errors.New("no containers found in job template")
+	}
+
+	idx := 0
+	if oo.Container != "" {
+		idx = -1
+		for i, co := range spec.Containers {
+			if co.Name == oo.Container {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return fmt.Errorf("no container named %q in job template", oo.Container)
+		}
+	}
+
+	co := &spec.Containers[idx]
+	for _, e := range oo.Env {
+		found := false
+		for i := range co.Env {
+			if co.Env[i].Name == e.Name {
+				co.Env[i], found = e, true
+				break
+			}
+		}
+		if !found {
+			co.Env = append(co.Env, e)
+		}
+	}
+	if len(oo.Args) > 0 {
+		co.Args = oo.Args
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestParseJobOverrides(t *testing.T) {
+	uu := map[string]struct {
+		env  []string
+		args string
+		e    JobOverrides
+		err  bool
+	}{
+		"empty": {
+			e: JobOverrides{Container: "c1", Args: []string{}},
+		},
+		"full": {
+			env:  []string{"A=1", "B=x=y"},
+			args: " --dry-run  -v ",
+			e: JobOverrides{
+				Container: "c1",
+				Env:       []v1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "x=y"}},
+				Args:      []string{"--dry-run", "-v"},
+			},
+		},
+		"bad-env": {
+			env: []string{"A"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := ParseJobOverrides("c1", u.env, u.args)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, oo)
+		})
+	}
+}
+
+func TestApplyJobOverrides(t *testing.T) {
+	uu := map[string]struct {
+		oo  JobOverrides
+		e   []v1.Container
+		err bool
+	}{
+		"none": {
+			e: []v1.Container{
+				{Name: "c1", Args: []string{"a"}, Env: []v1.EnvVar{{Name: "A", Value: "1"}}},
+				{Name: "c2"},
+			},
+		},
+		"first": {
+			oo: JobOverrides{
+				Env:  []v1.EnvVar{{Name: "A", Value: "2"}, {Name: "B", Value: "3"}},
+				Args: []string{"b"},
+			},
+			e: []v1.Container{
+				{Name: "c1", Args: []string{"b"}, Env: []v1.EnvVar{{Name: "A", Value: "2"}, {Name: "B", Value: "3"}}},
+				{Name: "c2"},
+			},
+		},
+		"named": {
+			oo: JobOverrides{Container: "c2", Env: []v1.EnvVar{{Name: "B", Value: "3"}}},
+			e: []v1.Container{
+				{Name: "c1", Args: []string{"a"}, Env: []v1.EnvVar{{Name: "A", Value: "1"}}},
+				{Name: "c2", Env: []v1.EnvVar{{Name: "B", Value: "3"}}},
+			},
+		},
+		"unknown": {
+			oo:  JobOverrides{Container: "c3", Args: []string{"b"}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec := v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "c1", Args: []string{"a"}, Env: []v1.EnvVar{{Name: "A", Value: "1"}}},
+					{Name: "c2"},
+				},
+			}
+			err := applyJobOverrides(&spec, u.oo)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec.Containers)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"github.com/open-infra/osc/internal/client"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kubectl/pkg/util/openapi"
+	"sigs.k8s.io/yaml"
+)
+
+// Explain returns a resource schema fields tree as published by the api
+// server OpenAPI spec.
+func Explain(f Factory, gvr client.GVR) (*SchemaField, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := dial.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	res, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, err
+	}
+	gvk := schema.GroupVersionKind{Group: gvr.G(), Version: gvr.V(), Kind: meta.Kind}
+	s := res.LookupResource(gvk)
+	if s == nil {
+		return nil, fmt.Errorf("no schema found for %s", gvr)
+	}
+
+	return NewProtoSchemaField(meta.Kind, s, ProtoDefaults(doc)), nil
+}
+
+// ProtoDefaults returns the OpenAPI v2 definitions properties defaults as
+// json keyed by their proto schema path.
+func ProtoDefaults(doc *openapi_v2.Document) map[string]string {
+	mm := make(map[string]string)
+	for _, d := range doc.GetDefinitions().GetAdditionalProperties() {
+		for _, p := range d.GetValue().GetProperties().GetAdditionalProperties() {
+			def := p.GetValue().GetDefault()
+			if def == nil {
+				continue
+			}
+			raw, err := yaml.YAMLToJSON([]byte(def.GetYaml()))
+			if err != nil {
+				continue
+			}
+			mm[d.GetName()+"."+p.GetName()] = strings.TrimSpace(string(raw))
+		}
+	}
+
+	return mm
+}
+
+// NewProtoSchemaField returns a field tree from an OpenAPI v2 proto schema.
+// Fields defaults are looked up by schema path.
+func NewProtoSchemaField(name string, s proto.Schema, defaults map[string]string) *SchemaField {
+	return newProtoField(name, "", s, false, defaults, make(map[string]struct{}))
+}
+
+// newProtoField converts a proto schema. Recursive references are not
+// expanded past their first occurrence on a given path.
+func newProtoField(name, path string, s proto.Schema, required bool, defaults map[string]string, refs map[string]struct{}) *SchemaField {
+	f := SchemaField{
+		Name:        name,
+		Path:        path,
+		Type:        protoType(s),
+		Description: s.GetDescription(),
+		Default:     defaults[s.GetPath().String()],
+		Required:    required,
+	}
+
+	body := protoBody(s)
+	if r, ok := body.(proto.Reference); ok {
+		if _, ok := refs[r.Reference()]; ok {
+			return &f
+		}
+		refs[r.Reference()] = struct{}{}
+		defer delete(refs, r.Reference())
+		body = protoBody(r.SubSchema())
+	}
+	if f.Description == "" {
+		f.Description = body.GetDescription()
+	}
+	k, ok := body.(*proto.Kind)
+	if !ok {
+		return &f
+	}
+	for _, key := range k.Keys() {
+		f.Fields = append(f.Fields, newProtoField(key, schemaPath(path, key), k.Fields[key], k.IsRequired(key), defaults, refs))
+	}
+
+	return &f
+}
+
+// protoBody returns the schema describing sub fields for arrays and maps.
+func protoBody(s proto.Schema) proto.Schema {
+	switch t := s.(type) {
+	case *proto.Array:
+		return protoBody(t.SubType)
+	case *proto.Map:
+		return protoBody(t.SubType)
+	default:
+		return s
+	}
+}
+
+func protoType(s proto.Schema) string {
+	switch t := s.(type) {
+	case *proto.Array:
+		return "[]" + protoType(t.SubType)
+	case *proto.Map:
+		return "map[string]" + protoType(t.SubType)
+	case *proto.Primitive:
+		if t.Format == "int-or-string" {
+			return t.Format
+		}
+		return t.Type
+	case proto.Reference:
+		if _, ok := t.SubSchema().(*proto.Kind); ok {
+			return "object"
+		}
+		return protoType(t.SubSchema())
+	case *proto.Kind, *proto.Arbitrary:
+		return "object"
+	default:
+		return strings.ToLower(s.GetName())
+	}
+}
@@ -0,0 +1,114 @@
+package dao_test
+
+import (
+	"testing"
+
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+type testRef struct {
+	proto.BaseSchema
+
+	name string
+	sub  func() proto.Schema
+}
+
+func (r *testRef) Accept(v proto.SchemaVisitor) {}
+func (r *testRef) GetName() string              { return r.name }
+func (r *testRef) Reference() string            { return r.name }
+func (r *testRef) SubSchema() proto.Schema      { return r.sub() }
+
+func TestNewProtoSchemaField(t *testing.T) {
+	var props *proto.Kind
+	propsRef := &testRef{name: "props", sub: func() proto.Schema { return props }}
+	props = &proto.Kind{
+		BaseSchema: proto.BaseSchema{Description: "Schema props."},
+		Fields: map[string]proto.Schema{
+			"type":  &proto.Primitive{Type: "string"},
+			"items": propsRef,
+		},
+	}
+	cpath := proto.NewPath("io.k8s.api.core.v1.Container")
+	container := &proto.Kind{
+		BaseSchema:     proto.BaseSchema{Description: "A container.", Path: cpath},
+		RequiredFields: []string{"name"},
+		Fields: map[string]proto.Schema{
+			"name":  &proto.Primitive{Type: "string", BaseSchema: proto.BaseSchema{Description: "Container name."}},
+			"ports": &proto.Array{SubType: &proto.Primitive{Type: "integer", Format: "int32"}},
+			"pull":  &proto.Primitive{Type: "string", BaseSchema: proto.BaseSchema{Path: cpath.FieldPath("pull")}},
+		},
+	}
+	root := &proto.Kind{
+		BaseSchema: proto.BaseSchema{Description: "Pod is a collection of containers."},
+		Fields: map[string]proto.Schema{
+			"containers": &proto.Array{SubType: &testRef{name: "container", sub: func() proto.Schema { return container }}},
+			"labels":     &proto.Map{SubType: &proto.Primitive{Type: "string"}},
+			"port":       &proto.Primitive{Type: "string", Format: "int-or-string"},
+			"schema":     propsRef,
+		},
+	}
+
+	f := dao.NewProtoSchemaField("Pod", root, map[string]string{
+		"io.k8s.api.core.v1.Container.pull": `"Always"`,
+	})
+	assert.Equal(t, "Pod", f.Name)
+	assert.Equal(t, "object", f.Type)
+	assert.Equal(t, "Pod is a collection of containers.", f.Description)
+
+	uu := map[string]struct {
+		typ, desc, def string
+		required       bool
+		fields         int
+	}{
+		"containers":       {typ: "[]object", desc: "A container.", fields: 3},
+		"containers.pull":  {typ: "string", def: `"Always"`},
+		"containers.name":  {typ: "string", desc: "Container name.", required: true},
+		"containers.ports": {typ: "[]integer"},
+		"labels":           {typ: "map[string]string"},
+		"port":             {typ: "int-or-string"},
+		"schema":           {typ: "object", desc: "Schema props.", fields: 2},
+		"schema.items":     {typ: "object"},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sf, ok := f.Find(k)
+			assert.True(t, ok)
+			assert.Equal(t, k, sf.Path)
+			assert.Equal(t, u.typ, sf.Type)
+			assert.Equal(t, u.desc, sf.Description)
+			assert.Equal(t, u.def, sf.Default)
+			assert.Equal(t, u.required, sf.Required)
+			assert.Equal(t, u.fields, len(sf.Fields))
+		})
+	}
+}
+
+func TestProtoDefaults(t *testing.T) {
+	doc := openapi_v2.Document{
+		Definitions: &openapi_v2.Definitions{
+			AdditionalProperties: []*openapi_v2.NamedSchema{
+				{
+					Name: "io.k8s.api.core.v1.Container",
+					Value: &openapi_v2.Schema{
+						Properties: &openapi_v2.Properties{
+							AdditionalProperties: []*openapi_v2.NamedSchema{
+								{Name: "name", Value: &openapi_v2.Schema{}},
+								{Name: "pull", Value: &openapi_v2.Schema{Default: &openapi_v2.Any{Yaml: "Always\n"}}},
+								{Name: "port", Value: &openapi_v2.Schema{Default: &openapi_v2.Any{Yaml: "80\n"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"io.k8s.api.core.v1.Container.pull": `"Always"`,
+		"io.k8s.api.core.v1.Container.port": "80",
+	}, dao.ProtoDefaults(&doc))
+}
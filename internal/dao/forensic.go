@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Forensic)(nil)
+	_ Loggable = (*Forensic)(nil)
+)
+
+// Forensic represents a pod restarts forensics.
+type Forensic struct {
+	NonResource
+}
+
+// List returns a pod containers terminations correlated with the pod events.
+func (f *Forensic) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", f.gvr)
+	}
+
+	o, err := f.Factory.Get("v1/pods", fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+	for _, cs := range po.Status.InitContainerStatuses {
+		res = append(res, render.NewContainerForensic(cs, true))
+	}
+	for _, cs := range po.Status.ContainerStatuses {
+		res = append(res, render.NewContainerForensic(cs, false))
+	}
+
+	ee, err := f.podEvents(&po)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range ee {
+		res = append(res, render.NewEventForensic(ev))
+	}
+
+	return res, nil
+}
+
+// TailLogs tails a given container logs.
+func (f *Forensic) TailLogs(ctx context.Context, logChan LogChan, opts LogOptions) error {
+	po := Pod{}
+	po.Init(f.Factory, client.NewGVR("v1/pods"))
+
+	return po.TailLogs(ctx, logChan, opts)
+}
+
+func (f *Forensic) podEvents(po *v1.Pod) ([]v1.Event, error) {
+	oo, err := f.Factory.List("v1/events", po.Namespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		ref := ev.InvolvedObject
+		if ref.Kind != "Pod" || ref.Name != po.Name {
+			continue
+		}
+		if ref.UID != "" && ref.UID != po.UID {
+			continue
+		}
+		ee = append(ee, ev)
+	}
+
+	return ee, nil
+}
@@ -4,6 +4,7 @@ package dao
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"regexp"
 
@@ -43,6 +44,16 @@ func toPerc(v1, v2 float64) float64 {
 	return math.Round((v1 / v2) * 100)
 }
 
+// fromUnstructured converts a raw resource into the given typed object.
+func fromUnstructured(o runtime.Object, obj interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
+
 // Truncate a string to the given l and suffix ellipsis if needed.
 func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
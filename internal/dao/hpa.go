@@ -2,24 +2,139 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
 	"github.com/rs/zerolog/log"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// HPAUtilization represents a resource utilization target in percent.
+	HPAUtilization = "Utilization"
+
+	// HPAAverageValue represents a per pod average value target.
+	HPAAverageValue = "AverageValue"
+
+	// HPAValue represents a raw value target.
+	HPAValue = "Value"
+
+	// hpaTolerance represents the usage ratio variation an HPA ignores.
+	hpaTolerance = 0.1
+
+	// hpaDefaultCPUTarget represents the v1 HPA default cpu utilization target.
+	hpaDefaultCPUTarget = 80
+
+	hpaConditionsAnnotation = "autoscaling.alpha.kubernetes.io/conditions"
+)
+
 var (
 	_ Accessor = (*HorizontalPodAutoscaler)(nil)
 	_ Nuker    = (*HorizontalPodAutoscaler)(nil)
 )
 
+var hpaGVRs = []string{
+	"autoscaling/v2beta2/horizontalpodautoscalers",
+	"autoscaling/v2beta1/horizontalpodautoscalers",
+	"autoscaling/v1/horizontalpodautoscalers",
+}
+
+// HPAMetric tracks an HPA metric current and target values. Utilizations
+// are expressed in percent and quantities in milli units.
+type HPAMetric struct {
+	Name    string
+	Type    string
+	Current int64
+	Target  int64
+	Known   bool
+	Desired int32
+}
+
+// FormatValue returns a metric value in human readable form.
+func (m HPAMetric) FormatValue(v int64) string {
+	if m.Type == HPAUtilization {
+		return strconv.FormatInt(v, 10) + "%"
+	}
+
+	return resource.NewMilliQuantity(v, resource.DecimalSI).String()
+}
+
+// HPACondition tracks an HPA status condition.
+type HPACondition struct {
+	Type, Status, Reason, Message string
+	LastTransition                time.Time
+}
+
+// HPAEvent tracks an HPA scaling event.
+type HPAEvent struct {
+	Type, Reason, Message string
+	Count                 int32
+	At                    time.Time
+}
+
+// HPAStatus tracks an HPA scaling state.
+type HPAStatus struct {
+	Path                             string
+	Reference                        string
+	MinReplicas, MaxReplicas         int32
+	CurrentReplicas, DesiredReplicas int32
+	LastScale                        time.Time
+	Metrics                          []HPAMetric
+	Conditions                       []HPACondition
+	Behavior                         []string
+	Events                           []HPAEvent
+}
+
+// Recommended returns the replicas the metrics call for within the HPA
+// bounds along with the name of the metric driving the recommendation.
+func (s *HPAStatus) Recommended() (int32, string) {
+	var (
+		desired int32 = -1
+		driver  string
+	)
+	for _, m := range s.Metrics {
+		if m.Known && m.Desired > desired {
+			desired, driver = m.Desired, m.Name
+		}
+	}
+	if desired < 0 {
+		return s.CurrentReplicas, ""
+	}
+	if desired < s.MinReplicas {
+		desired = s.MinReplicas
+	}
+	if desired > s.MaxReplicas {
+		desired = s.MaxReplicas
+	}
+
+	return desired, driver
+}
+
 // HorizontalPodAutoscaler represents a HPA resource model.
 type HorizontalPodAutoscaler struct {
 	Resource
 }
 
+// CanWatch returns false as HPAs are listed across api versions.
+func (h *HorizontalPodAutoscaler) CanWatch() bool {
+	return false
+}
+
 // List returns a collection of nodes.
 func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
@@ -28,13 +143,7 @@ func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtim
 		lsel = sel.AsSelector()
 	}
 
-	gvrs := []string{
-		"autoscaling/v2beta2/horizontalpodautoscalers",
-		"autoscaling/v2beta1/horizontalpodautoscalers",
-		"autoscaling/v1/horizontalpodautoscalers",
-	}
-
-	for _, gvr := range gvrs {
+	for _, gvr := range hpaGVRs {
 		oo, err := h.list(gvr, ns, lsel)
 		if err == nil && len(oo) > 0 {
 			return oo, nil
@@ -45,6 +154,48 @@ func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtim
 	return []runtime.Object{}, nil
 }
 
+// Scaling returns an HPA scaling state without its events.
+func (h *HorizontalPodAutoscaler) Scaling(path string) (*HPAStatus, error) {
+	o, err := h.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHPAStatus(o)
+}
+
+// Status returns an HPA scaling state and events.
+func (h *HorizontalPodAutoscaler) Status(path string) (*HPAStatus, error) {
+	o, err := h.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := NewHPAStatus(o)
+	if err != nil {
+		return nil, err
+	}
+	if st.Events, err = h.events(o); err != nil {
+		log.Warn().Err(err).Msgf("HPA events %q", path)
+	}
+
+	return st, nil
+}
+
+func (h *HorizontalPodAutoscaler) get(path string) (runtime.Object, error) {
+	var (
+		o   runtime.Object
+		err error
+	)
+	for _, gvr := range hpaGVRs {
+		if o, err = h.Factory.Get(gvr, path, true, labels.Everything()); err == nil {
+			break
+		}
+	}
+
+	return o, err
+}
+
 func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
 	oo, err := h.Factory.List(gvr, ns, true, sel)
 	if err != nil {
@@ -52,3 +203,374 @@ func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]r
 	}
 	return oo, nil
 }
+
+func (h *HorizontalPodAutoscaler) events(o runtime.Object) ([]HPAEvent, error) {
+	m, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured HPA but got %T", o)
+	}
+	oo, err := h.Factory.List("v1/events", m.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]HPAEvent, 0, len(oo))
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		ref := ev.InvolvedObject
+		if ref.Kind != "HorizontalPodAutoscaler" || ref.Name != m.GetName() {
+			continue
+		}
+		if ref.UID != "" && ref.UID != m.GetUID() {
+			continue
+		}
+		at := ev.LastTimestamp.Time
+		if at.IsZero() {
+			at = ev.EventTime.Time
+		}
+		ee = append(ee, HPAEvent{
+			Type:    ev.Type,
+			Reason:  ev.Reason,
+			Message: ev.Message,
+			Count:   ev.Count,
+			At:      at,
+		})
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].At.After(ee[j].At)
+	})
+
+	return ee, nil
+}
+
+// NewHPAStatus returns the scaling state of an HPA of any supported version.
+func NewHPAStatus(o runtime.Object) (*HPAStatus, error) {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured HPA but got %T", o)
+	}
+
+	switch v := raw.GetAPIVersion(); v {
+	case "autoscaling/v1":
+		var hpa autoscalingv1.HorizontalPodAutoscaler
+		if err := fromUnstructured(raw, &hpa); err != nil {
+			return nil, err
+		}
+		return hpaStatusV1(&hpa), nil
+	case "autoscaling/v2beta1":
+		var hpa autoscalingv2beta1.HorizontalPodAutoscaler
+		if err := fromUnstructured(raw, &hpa); err != nil {
+			return nil, err
+		}
+		return hpaStatusV2b1(&hpa), nil
+	case "autoscaling/v2beta2":
+		var hpa autoscalingv2beta2.HorizontalPodAutoscaler
+		if err := fromUnstructured(raw, &hpa); err != nil {
+			return nil, err
+		}
+		return hpaStatusV2b2(&hpa), nil
+	default:
+		return nil, fmt.Errorf("unhandled HPA version %q", v)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newHPAStatus(m metav1.ObjectMeta, ref autoscalingv1.CrossVersionObjectReference, min *int32, max, current, desired int32, last *metav1.Time) *HPAStatus {
+	st := HPAStatus{
+		Path:            client.MetaFQN(m),
+		Reference:       ref.Kind + "/" + ref.Name,
+		MinReplicas:     1,
+		MaxReplicas:     max,
+		CurrentReplicas: current,
+		DesiredReplicas: desired,
+	}
+	if min != nil {
+		st.MinReplicas = *min
+	}
+	if last != nil {
+		st.LastScale = last.Time
+	}
+
+	return &st
+}
+
+func hpaStatusV1(hpa *autoscalingv1.HorizontalPodAutoscaler) *HPAStatus {
+	st := newHPAStatus(hpa.ObjectMeta, hpa.Spec.ScaleTargetRef, hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas,
+		hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, hpa.Status.LastScaleTime)
+
+	target := int32(hpaDefaultCPUTarget)
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		target = *hpa.Spec.TargetCPUUtilizationPercentage
+	}
+	st.Metrics = []HPAMetric{
+		newHPAMetric(
+			string(v1.ResourceCPU),
+			autoscalingv2beta2.MetricTarget{Type: autoscalingv2beta2.UtilizationMetricType, AverageUtilization: &target},
+			&autoscalingv2beta2.MetricValueStatus{AverageUtilization: hpa.Status.CurrentCPUUtilizationPercentage},
+			st.CurrentReplicas,
+		),
+	}
+
+	// v1 HPAs only surface their conditions as an annotation.
+	if raw, ok := hpa.Annotations[hpaConditionsAnnotation]; ok {
+		var cc []autoscalingv1.HorizontalPodAutoscalerCondition
+		if err := json.Unmarshal([]byte(raw), &cc); err != nil {
+			log.Warn().Err(err).Msgf("HPA conditions %q", st.Path)
+		}
+		for _, c := range cc {
+			st.Conditions = append(st.Conditions, HPACondition{
+				Type:           string(c.Type),
+				Status:         string(c.Status),
+				Reason:         c.Reason,
+				Message:        c.Message,
+				LastTransition: c.LastTransitionTime.Time,
+			})
+		}
+	}
+
+	return st
+}
+
+func hpaStatusV2b1(hpa *autoscalingv2beta1.HorizontalPodAutoscaler) *HPAStatus {
+	ref := autoscalingv1.CrossVersionObjectReference(hpa.Spec.ScaleTargetRef)
+	st := newHPAStatus(hpa.ObjectMeta, ref, hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas,
+		hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, hpa.Status.LastScaleTime)
+
+	for i, spec := range hpa.Spec.Metrics {
+		var status *autoscalingv2beta1.MetricStatus
+		if i < len(hpa.Status.CurrentMetrics) && hpa.Status.CurrentMetrics[i].Type == spec.Type {
+			status = &hpa.Status.CurrentMetrics[i]
+		}
+		if m, ok := v2b1Metric(spec, status, st.CurrentReplicas); ok {
+			st.Metrics = append(st.Metrics, m)
+		}
+	}
+	for _, c := range hpa.Status.Conditions {
+		st.Conditions = append(st.Conditions, HPACondition{
+			Type:           string(c.Type),
+			Status:         string(c.Status),
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastTransition: c.LastTransitionTime.Time,
+		})
+	}
+
+	return st
+}
+
+func hpaStatusV2b2(hpa *autoscalingv2beta2.HorizontalPodAutoscaler) *HPAStatus {
+	ref := autoscalingv1.CrossVersionObjectReference(hpa.Spec.ScaleTargetRef)
+	st := newHPAStatus(hpa.ObjectMeta, ref, hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas,
+		hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, hpa.Status.LastScaleTime)
+
+	for i, spec := range hpa.Spec.Metrics {
+		var status *autoscalingv2beta2.MetricStatus
+		if i < len(hpa.Status.CurrentMetrics) && hpa.Status.CurrentMetrics[i].Type == spec.Type {
+			status = &hpa.Status.CurrentMetrics[i]
+		}
+		if m, ok := v2b2Metric(spec, status, st.CurrentReplicas); ok {
+			st.Metrics = append(st.Metrics, m)
+		}
+	}
+	for _, c := range hpa.Status.Conditions {
+		st.Conditions = append(st.Conditions, HPACondition{
+			Type:           string(c.Type),
+			Status:         string(c.Status),
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastTransition: c.LastTransitionTime.Time,
+		})
+	}
+	if b := hpa.Spec.Behavior; b != nil {
+		if b.ScaleUp != nil {
+			st.Behavior = append(st.Behavior, "ScaleUp: "+scalingRules(b.ScaleUp))
+		}
+		if b.ScaleDown != nil {
+			st.Behavior = append(st.Behavior, "ScaleDown: "+scalingRules(b.ScaleDown))
+		}
+	}
+
+	return st
+}
+
+func v2b1Metric(spec autoscalingv2beta1.MetricSpec, status *autoscalingv2beta1.MetricStatus, replicas int32) (HPAMetric, bool) {
+	var (
+		name   string
+		target autoscalingv2beta2.MetricTarget
+		cur    autoscalingv2beta2.MetricValueStatus
+	)
+	switch spec.Type {
+	case autoscalingv2beta1.ResourceMetricSourceType:
+		if spec.Resource == nil {
+			return HPAMetric{}, false
+		}
+		name = string(spec.Resource.Name)
+		if spec.Resource.TargetAverageUtilization != nil {
+			target.Type, target.AverageUtilization = autoscalingv2beta2.UtilizationMetricType, spec.Resource.TargetAverageUtilization
+		} else {
+			target.Type, target.AverageValue = autoscalingv2beta2.AverageValueMetricType, spec.Resource.TargetAverageValue
+		}
+		if status != nil && status.Resource != nil {
+			cur.AverageUtilization, cur.AverageValue = status.Resource.CurrentAverageUtilization, &status.Resource.CurrentAverageValue
+		}
+	case autoscalingv2beta1.PodsMetricSourceType:
+		if spec.Pods == nil {
+			return HPAMetric{}, false
+		}
+		name = "pods/" + spec.Pods.MetricName
+		target.Type, target.AverageValue = autoscalingv2beta2.AverageValueMetricType, &spec.Pods.TargetAverageValue
+		if status != nil && status.Pods != nil {
+			cur.AverageValue = &status.Pods.CurrentAverageValue
+		}
+	case autoscalingv2beta1.ObjectMetricSourceType:
+		if spec.Object == nil {
+			return HPAMetric{}, false
+		}
+		name = "object/" + spec.Object.MetricName
+		if spec.Object.AverageValue != nil {
+			target.Type, target.AverageValue = autoscalingv2beta2.AverageValueMetricType, spec.Object.AverageValue
+		} else {
+			target.Type, target.Value = autoscalingv2beta2.ValueMetricType, &spec.Object.TargetValue
+		}
+		if status != nil && status.Object != nil {
+			cur.Value, cur.AverageValue = &status.Object.CurrentValue, status.Object.AverageValue
+		}
+	case autoscalingv2beta1.ExternalMetricSourceType:
+		if spec.External == nil {
+			return HPAMetric{}, false
+		}
+		name = "external/" + spec.External.MetricName
+		if spec.External.TargetAverageValue != nil {
+			target.Type, target.AverageValue = autoscalingv2beta2.AverageValueMetricType, spec.External.TargetAverageValue
+		} else {
+			target.Type, target.Value = autoscalingv2beta2.ValueMetricType, spec.External.TargetValue
+		}
+		if status != nil && status.External != nil {
+			cur.Value, cur.AverageValue = &status.External.CurrentValue, status.External.CurrentAverageValue
+		}
+	default:
+		return HPAMetric{}, false
+	}
+	if status == nil {
+		return newHPAMetric(name, target, nil, replicas), true
+	}
+
+	return newHPAMetric(name, target, &cur, replicas), true
+}
+
+func v2b2Metric(spec autoscalingv2beta2.MetricSpec, status *autoscalingv2beta2.MetricStatus, replicas int32) (HPAMetric, bool) {
+	var (
+		name   string
+		target autoscalingv2beta2.MetricTarget
+		cur    *autoscalingv2beta2.MetricValueStatus
+	)
+	switch spec.Type {
+	case autoscalingv2beta2.ResourceMetricSourceType:
+		if spec.Resource == nil {
+			return HPAMetric{}, false
+		}
+		name, target = string(spec.Resource.Name), spec.Resource.Target
+		if status != nil && status.Resource != nil {
+			cur = &status.Resource.Current
+		}
+	case autoscalingv2beta2.PodsMetricSourceType:
+		if spec.Pods == nil {
+			return HPAMetric{}, false
+		}
+		name, target = "pods/"+spec.Pods.Metric.Name, spec.Pods.Target
+		if status != nil && status.Pods != nil {
+			cur = &status.Pods.Current
+		}
+	case autoscalingv2beta2.ObjectMetricSourceType:
+		if spec.Object == nil {
+			return HPAMetric{}, false
+		}
+		name, target = "object/"+spec.Object.Metric.Name, spec.Object.Target
+		if status != nil && status.Object != nil {
+			cur = &status.Object.Current
+		}
+	case autoscalingv2beta2.ExternalMetricSourceType:
+		if spec.External == nil {
+			return HPAMetric{}, false
+		}
+		name, target = "external/"+spec.External.Metric.Name, spec.External.Target
+		if status != nil && status.External != nil {
+			cur = &status.External.Current
+		}
+	default:
+		return HPAMetric{}, false
+	}
+
+	return newHPAMetric(name, target, cur, replicas), true
+}
+
+func newHPAMetric(name string, target autoscalingv2beta2.MetricTarget, cur *autoscalingv2beta2.MetricValueStatus, replicas int32) HPAMetric {
+	m := HPAMetric{Name: name, Type: string(target.Type)}
+	switch target.Type {
+	case autoscalingv2beta2.UtilizationMetricType:
+		if target.AverageUtilization != nil {
+			m.Target = int64(*target.AverageUtilization)
+		}
+		if cur != nil && cur.AverageUtilization != nil {
+			m.Current, m.Known = int64(*cur.AverageUtilization), true
+		}
+	case autoscalingv2beta2.AverageValueMetricType:
+		if target.AverageValue != nil {
+			m.Target = target.AverageValue.MilliValue()
+		}
+		if cur != nil && cur.AverageValue != nil {
+			m.Current, m.Known = cur.AverageValue.MilliValue(), true
+		}
+	default:
+		m.Type = HPAValue
+		if target.Value != nil {
+			m.Target = target.Value.MilliValue()
+		}
+		if cur != nil && cur.Value != nil {
+			m.Current, m.Known = cur.Value.MilliValue(), true
+		}
+	}
+	if m.Known {
+		m.Desired = desiredReplicas(replicas, m.Current, m.Target)
+	}
+
+	return m
+}
+
+// desiredReplicas computes the replicas an HPA calls for given a metric
+// usage and target.
+func desiredReplicas(replicas int32, usage, target int64) int32 {
+	if replicas == 0 || target <= 0 {
+		return replicas
+	}
+	ratio := float64(usage) / float64(target)
+	if math.Abs(1.0-ratio) <= hpaTolerance {
+		return replicas
+	}
+
+	return int32(math.Ceil(ratio * float64(replicas)))
+}
+
+func scalingRules(r *autoscalingv2beta2.HPAScalingRules) string {
+	ss := make([]string, 0, len(r.Policies)+2)
+	if r.StabilizationWindowSeconds != nil {
+		ss = append(ss, fmt.Sprintf("window=%s", time.Duration(*r.StabilizationWindowSeconds)*time.Second))
+	}
+	if r.SelectPolicy != nil {
+		ss = append(ss, "select="+string(*r.SelectPolicy))
+	}
+	for _, p := range r.Policies {
+		unit := ""
+		if p.Type == autoscalingv2beta2.PercentScalingPolicy {
+			unit = "%"
+		}
+		ss = append(ss, fmt.Sprintf("%s=%d%s/%ds", strings.ToLower(string(p.Type)), p.Value, unit, p.PeriodSeconds))
+	}
+
+	return strings.Join(ss, " ")
+}
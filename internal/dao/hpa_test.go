@@ -0,0 +1,183 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNewHPAStatusV1(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "fred",
+			"annotations": map[string]interface{}{
+				"autoscaling.alpha.kubernetes.io/conditions": `[{"type":"AbleToScale","status":"True","reason":"SucceededRescale"}]`,
+			},
+		},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"kind": "Deployment", "name": "fred"},
+			"minReplicas":    int64(2),
+			"maxReplicas":    int64(10),
+		},
+		"status": map[string]interface{}{
+			"currentReplicas":                 int64(2),
+			"desiredReplicas":                 int64(4),
+			"currentCPUUtilizationPercentage": int64(160),
+		},
+	}}
+
+	st, err := dao.NewHPAStatus(o)
+	assert.Nil(t, err)
+	assert.Equal(t, "default/fred", st.Path)
+	assert.Equal(t, "Deployment/fred", st.Reference)
+	assert.Equal(t, []dao.HPAMetric{
+		{Name: "cpu", Type: dao.HPAUtilization, Current: 160, Target: 80, Known: true, Desired: 4},
+	}, st.Metrics)
+	assert.Equal(t, 1, len(st.Conditions))
+	assert.Equal(t, "AbleToScale", st.Conditions[0].Type)
+	assert.Equal(t, "SucceededRescale", st.Conditions[0].Reason)
+}
+
+func TestNewHPAStatusV2b2(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v2beta2",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "fred",
+		},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"kind": "Deployment", "name": "fred"},
+			"maxReplicas":    int64(5),
+			"metrics": []interface{}{
+				map[string]interface{}{
+					"type": "Resource",
+					"resource": map[string]interface{}{
+						"name":   "cpu",
+						"target": map[string]interface{}{"type": "Utilization", "averageUtilization": int64(50)},
+					},
+				},
+				map[string]interface{}{
+					"type": "Pods",
+					"pods": map[string]interface{}{
+						"metric": map[string]interface{}{"name": "rps"},
+						"target": map[string]interface{}{"type": "AverageValue", "averageValue": "10"},
+					},
+				},
+				map[string]interface{}{
+					"type": "External",
+					"external": map[string]interface{}{
+						"metric": map[string]interface{}{"name": "queue"},
+						"target": map[string]interface{}{"type": "Value", "value": "100"},
+					},
+				},
+			},
+			"behavior": map[string]interface{}{
+				"scaleDown": map[string]interface{}{
+					"stabilizationWindowSeconds": int64(300),
+					"policies": []interface{}{
+						map[string]interface{}{"type": "Percent", "value": int64(10), "periodSeconds": int64(60)},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"currentReplicas": int64(2),
+			"desiredReplicas": int64(2),
+			"currentMetrics": []interface{}{
+				map[string]interface{}{
+					"type": "Resource",
+					"resource": map[string]interface{}{
+						"name":    "cpu",
+						"current": map[string]interface{}{"averageUtilization": int64(52), "averageValue": "100m"},
+					},
+				},
+				map[string]interface{}{
+					"type": "Pods",
+					"pods": map[string]interface{}{
+						"metric":  map[string]interface{}{"name": "rps"},
+						"current": map[string]interface{}{"averageValue": "25"},
+					},
+				},
+			},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "ScalingLimited", "status": "False", "reason": "DesiredWithinRange"},
+			},
+		},
+	}}
+
+	st, err := dao.NewHPAStatus(o)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), st.MinReplicas)
+	assert.Equal(t, []dao.HPAMetric{
+		{Name: "cpu", Type: dao.HPAUtilization, Current: 52, Target: 50, Known: true, Desired: 2},
+		{Name: "pods/rps", Type: dao.HPAAverageValue, Current: 25000, Target: 10000, Known: true, Desired: 5},
+		{Name: "external/queue", Type: dao.HPAValue, Target: 100000},
+	}, st.Metrics)
+	assert.Equal(t, []string{"ScaleDown: window=5m0s percent=10%/60s"}, st.Behavior)
+	assert.Equal(t, "ScalingLimited", st.Conditions[0].Type)
+
+	n, driver := st.Recommended()
+	assert.Equal(t, int32(5), n)
+	assert.Equal(t, "pods/rps", driver)
+}
+
+func TestNewHPAStatusToss(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v3",
+		"kind":       "HorizontalPodAutoscaler",
+	}}
+
+	_, err := dao.NewHPAStatus(o)
+	assert.NotNil(t, err)
+}
+
+func TestHPAStatusRecommended(t *testing.T) {
+	uu := map[string]struct {
+		st     dao.HPAStatus
+		e      int32
+		driver string
+	}{
+		"unknown": {
+			st: dao.HPAStatus{CurrentReplicas: 3, MinReplicas: 1, MaxReplicas: 5, Metrics: []dao.HPAMetric{
+				{Name: "cpu", Desired: 10},
+			}},
+			e: 3,
+		},
+		"capped": {
+			st: dao.HPAStatus{CurrentReplicas: 3, MinReplicas: 1, MaxReplicas: 5, Metrics: []dao.HPAMetric{
+				{Name: "cpu", Known: true, Desired: 2},
+				{Name: "memory", Known: true, Desired: 8},
+			}},
+			e:      5,
+			driver: "memory",
+		},
+		"floored": {
+			st: dao.HPAStatus{CurrentReplicas: 3, MinReplicas: 2, MaxReplicas: 5, Metrics: []dao.HPAMetric{
+				{Name: "cpu", Known: true, Desired: 1},
+			}},
+			e:      2,
+			driver: "cpu",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, driver := u.st.Recommended()
+			assert.Equal(t, u.e, n)
+			assert.Equal(t, u.driver, driver)
+		})
+	}
+}
+
+func TestHPAMetricFormatValue(t *testing.T) {
+	assert.Equal(t, "80%", dao.HPAMetric{Type: dao.HPAUtilization}.FormatValue(80))
+	assert.Equal(t, "250m", dao.HPAMetric{Type: dao.HPAAverageValue}.FormatValue(250))
+	assert.Equal(t, "10", dao.HPAMetric{Type: dao.HPAValue}.FormatValue(10000))
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/open-infra/osc/internal"
 	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,6 +21,7 @@ var (
 	_ Accessor = (*Job)(nil)
 	_ Nuker    = (*Job)(nil)
 	_ Loggable = (*Job)(nil)
+	_ Pruner   = (*Job)(nil)
 )
 
 // Job represents a K8s job resource.
@@ -56,6 +60,57 @@ func (j *Job) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return ll, nil
 }
 
+// Decorate returns an informer job unless filtered out by controller.
+func (j *Job) Decorate(ctx context.Context, o runtime.Object) (runtime.Object, error) {
+	o, err := j.Resource.Decorate(ctx, o)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	ctrl, _ := ctx.Value(internal.KeyPath).(string)
+	_, n := client.Namespaced(ctrl)
+	if n == "" {
+		return o, nil
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range m.GetOwnerReferences() {
+		if r.Name == n {
+			return o, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Prunable returns the given jobs that finished more than a given age ago.
+func (j *Job) Prunable(paths []string, age time.Duration) ([]string, error) {
+	if age <= 0 {
+		return nil, fmt.Errorf("prune age must be positive but got %s", age)
+	}
+
+	cutoff := time.Now().Add(-age)
+	victims := make([]string, 0, len(paths))
+	for _, path := range paths {
+		o, err := j.Factory.Get(j.gvr.String(), path, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Prune skipping job %q", path)
+			continue
+		}
+		var job batchv1.Job
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
+		if err != nil {
+			return nil, errors.New("expecting Job resource")
+		}
+		if at, ok := render.JobFinishedAt(&job); ok && at.Before(cutoff) {
+			victims = append(victims, path)
+		}
+	}
+
+	return victims, nil
+}
+
 // TailLogs tail logs for all pods represented by this Job.
 func (j *Job) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 	o, err := j.Factory.Get(j.gvr.String(), opts.Path, true, labels.Everything())
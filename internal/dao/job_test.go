@@ -0,0 +1,17 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobPrunableAge(t *testing.T) {
+	var j Job
+	for _, age := range []time.Duration{0, -time.Hour} {
+		victims, err := j.Prunable([]string{"default/fred"}, age)
+		assert.Error(t, err)
+		assert.Nil(t, victims)
+	}
+}
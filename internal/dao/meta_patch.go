@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-infra/osc/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ MetaPatcher = (*Generic)(nil)
+
+// MetaPatch tracks labels and annotations updates. A nil value removes a key.
+type MetaPatch struct {
+	Labels      map[string]*string `json:"labels,omitempty"`
+	Annotations map[string]*string `json:"annotations,omitempty"`
+}
+
+// IsEmpty returns true if the patch has no updates.
+func (m MetaPatch) IsEmpty() bool {
+	return len(m.Labels) == 0 && len(m.Annotations) == 0
+}
+
+// ParseMetaPatch parses kubectl style updates ie `a=b,c-` where a trailing dash removes a key.
+func ParseMetaPatch(s string) (map[string]*string, error) {
+	mm := make(map[string]*string)
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if strings.HasSuffix(t, "-") && !strings.Contains(t, "=") {
+			k := strings.TrimSuffix(t, "-")
+			if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid key %q: %s", k, strings.Join(errs, ", "))
+			}
+			mm[k] = nil
+			continue
+		}
+		tokens := strings.SplitN(t, "=", 2)
+		if len(tokens) != 2 {
+			return nil, fmt.Errorf("invalid update %q, expecting key=value or key-", t)
+		}
+		k, v := tokens[0], tokens[1]
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid key %q: %s", k, strings.Join(errs, ", "))
+		}
+		mm[k] = &v
+	}
+
+	return mm, nil
+}
+
+// MetaRows returns a sorted collection of `key=value` rows.
+func MetaRows(mm map[string]string) []string {
+	rr := make([]string, 0, len(mm))
+	for k, v := range mm {
+		rr = append(rr, k+"="+v)
+	}
+	sort.Strings(rr)
+
+	return rr
+}
+
+// ParseMetaRows parses a collection of `key=value` rows. Blank rows are skipped.
+func ParseMetaRows(rr []string) (map[string]string, error) {
+	mm := make(map[string]string, len(rr))
+	for _, r := range rr {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		tokens := strings.SplitN(r, "=", 2)
+		k := strings.TrimSpace(tokens[0])
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid key %q: %s", k, strings.Join(errs, ", "))
+		}
+		if len(tokens) == 1 {
+			mm[k] = ""
+			continue
+		}
+		mm[k] = tokens[1]
+	}
+
+	return mm, nil
+}
+
+// DiffMeta computes the merge patch updates needed to go from old to new.
+func DiffMeta(old, new map[string]string) map[string]*string {
+	mm := make(map[string]*string)
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			mm[k] = nil
+		}
+	}
+	for k, v := range new {
+		if ov, ok := old[k]; ok && ov == v {
+			continue
+		}
+		v := v
+		mm[k] = &v
+	}
+
+	return mm
+}
+
+// PatchMeta merges the given labels and annotations updates.
+func (g *Generic) PatchMeta(ctx context.Context, path string, p MetaPatch) error {
+	raw, err := json.Marshal(map[string]MetaPatch{"metadata": p})
+	if err != nil {
+		return err
+	}
+
+	return g.mergePatch(ctx, path, raw)
+}
+
+// mergePatch applies a JSON merge patch to a resource.
+func (g *Generic) mergePatch(ctx context.Context, path string, raw []byte) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Patch(ctx, n, types.MergePatchType, raw, metav1.PatchOptions{})
+		return err
+	}
+	_, err = dial.Namespace(ns).Patch(ctx, n, types.MergePatchType, raw, metav1.PatchOptions{})
+
+	return err
+}
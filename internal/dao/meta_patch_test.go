@@ -0,0 +1,105 @@
+package dao_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMetaPatch(t *testing.T) {
+	fred, empty := "fred", ""
+	uu := map[string]struct {
+		s   string
+		e   map[string]*string
+		err bool
+	}{
+		"blank": {
+			e: map[string]*string{},
+		},
+		"set": {
+			s: "app=fred, tier=",
+			e: map[string]*string{"app": &fred, "tier": &empty},
+		},
+		"remove": {
+			s: "app-,osc.io/team=fred",
+			e: map[string]*string{"app": nil, "osc.io/team": &fred},
+		},
+		"bad-key": {
+			s:   "-app=fred",
+			err: true,
+		},
+		"bad-update": {
+			s:   "app",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mm, err := dao.ParseMetaPatch(u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestMetaPatchJSON(t *testing.T) {
+	fred := "fred"
+	p := dao.MetaPatch{Labels: map[string]*string{"app": &fred, "tier": nil}}
+
+	raw, err := json.Marshal(p)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"labels":{"app":"fred","tier":null}}`, string(raw))
+	assert.False(t, p.IsEmpty())
+	assert.True(t, dao.MetaPatch{}.IsEmpty())
+}
+
+func TestMetaRows(t *testing.T) {
+	rr := dao.MetaRows(map[string]string{"tier": "web", "app": "fred"})
+	assert.Equal(t, []string{"app=fred", "tier=web"}, rr)
+
+	mm, err := dao.ParseMetaRows(append(rr, "", " osc.io/team "))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app": "fred", "tier": "web", "osc.io/team": ""}, mm)
+
+	_, err = dao.ParseMetaRows([]string{"-bad=fred"})
+	assert.Error(t, err)
+}
+
+func TestDiffMeta(t *testing.T) {
+	blee := "blee"
+	uu := map[string]struct {
+		old, new map[string]string
+		e        map[string]*string
+	}{
+		"same": {
+			old: map[string]string{"app": "fred"},
+			new: map[string]string{"app": "fred"},
+			e:   map[string]*string{},
+		},
+		"update": {
+			old: map[string]string{"app": "fred"},
+			new: map[string]string{"app": "blee"},
+			e:   map[string]*string{"app": &blee},
+		},
+		"add-remove": {
+			old: map[string]string{"app": "fred"},
+			new: map[string]string{"tier": "blee"},
+			e:   map[string]*string{"app": nil, "tier": &blee},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.DiffMeta(u.old, u.new))
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const netPolGVR = "networking.k8s.io/v1/networkpolicies"
+
+var _ Accessor = (*NetCheck)(nil)
+
+// NetCheckSpec describes a reachability query between two workloads.
+type NetCheckSpec struct {
+	// Source represents the source pod fqn.
+	Source string
+
+	// Destination represents the destination pod or service fqn.
+	Destination string
+
+	// IsService indicates the destination is a service.
+	IsService bool
+
+	// Port represents an optional destination port number or name.
+	Port string
+}
+
+// NetPolicy represents a network policy along with its ports ranges. The
+// vendored api types predate NetworkPolicyPort.EndPort so ranges upper bounds
+// are tracked separately, keyed by EndPortKey.
+type NetPolicy struct {
+	netv1.NetworkPolicy
+
+	EndPorts map[string]int32
+}
+
+// EndPortKey returns the key of a rule port range upper bound.
+func EndPortKey(dir netv1.PolicyType, rule, port int) string {
+	return fmt.Sprintf("%s[%d].ports[%d]", strings.ToLower(string(dir)), rule, port)
+}
+
+// NetCheck statically evaluates network policies between two pods.
+type NetCheck struct {
+	NonResource
+}
+
+// List returns the egress and ingress verdicts for each destination pod.
+func (n *NetCheck) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	spec, ok := ctx.Value(internal.KeyNetCheck).(NetCheckSpec)
+	if !ok {
+		return nil, fmt.Errorf("expecting a NetCheckSpec but got %T", ctx.Value(internal.KeyNetCheck))
+	}
+
+	var po Pod
+	po.Init(n.Factory, client.NewGVR("v1/pods"))
+	src, err := po.GetInstance(spec.Source)
+	if err != nil {
+		return nil, err
+	}
+	dsts, port, err := n.destinations(spec)
+	if err != nil {
+		return nil, err
+	}
+	pols, err := n.policies()
+	if err != nil {
+		return nil, err
+	}
+	nss, err := n.namespaceLabels()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, 2*len(dsts))
+	for _, dst := range dsts {
+		egress, ingress := EvalNetPolicies(src, dst, port, pols, nss)
+		res = append(res,
+			render.NetCheckRes{Direction: render.NetEgress, Source: src, Destination: dst, Port: port, Verdict: egress},
+			render.NetCheckRes{Direction: render.NetIngress, Source: src, Destination: dst, Port: port, Verdict: ingress},
+		)
+	}
+
+	return res, nil
+}
+
+// destinations resolves the destination pods and port. Service ports are mapped to their target ports.
+func (n *NetCheck) destinations(spec NetCheckSpec) ([]*v1.Pod, string, error) {
+	if !spec.IsService {
+		var po Pod
+		po.Init(n.Factory, client.NewGVR("v1/pods"))
+		dst, err := po.GetInstance(spec.Destination)
+		if err != nil {
+			return nil, "", err
+		}
+		return []*v1.Pod{dst}, spec.Port, nil
+	}
+
+	var s Service
+	s.Init(n.Factory, client.NewGVR("v1/services"))
+	svc, err := s.GetInstance(spec.Destination)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, "", fmt.Errorf("service %q has no selector", spec.Destination)
+	}
+	oo, err := n.Factory.List("v1/pods", svc.Namespace, true, labels.Set(svc.Spec.Selector).AsSelector())
+	if err != nil {
+		return nil, "", err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		po := new(v1.Pod)
+		if err := fromUnstructured(o, po); err != nil {
+			return nil, "", err
+		}
+		pp = append(pp, po)
+	}
+	if len(pp) == 0 {
+		return nil, "", fmt.Errorf("no pods backing service %q", spec.Destination)
+	}
+
+	return pp, targetPort(svc, spec.Port), nil
+}
+
+func (n *NetCheck) policies() ([]NetPolicy, error) {
+	oo, err := n.Factory.List(netPolGVR, client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]NetPolicy, 0, len(oo))
+	for _, o := range oo {
+		var np NetPolicy
+		if err := fromUnstructured(o, &np.NetworkPolicy); err != nil {
+			return nil, err
+		}
+		np.EndPorts = endPorts(o.(*unstructured.Unstructured))
+		pp = append(pp, np)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return client.MetaFQN(pp[i].ObjectMeta) < client.MetaFQN(pp[j].ObjectMeta)
+	})
+
+	return pp, nil
+}
+
+func (n *NetCheck) namespaceLabels() (map[string]labels.Set, error) {
+	oo, err := n.Factory.List("v1/namespaces", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nss := make(map[string]labels.Set, len(oo))
+	for _, o := range oo {
+		var ns v1.Namespace
+		if err := fromUnstructured(o, &ns); err != nil {
+			return nil, err
+		}
+		nss[ns.Name] = labels.Set(ns.Labels)
+	}
+
+	return nss, nil
+}
+
+// EvalNetPolicies statically evaluates whether src may reach dst on the given port.
+// An empty port matches any port.
+func EvalNetPolicies(src, dst *v1.Pod, port string, pols []NetPolicy, nss map[string]labels.Set) (egress, ingress render.NetVerdict) {
+	egress = evalDirection(netv1.PolicyTypeEgress, src, dst, dst, port, pols, nss)
+	ingress = evalDirection(netv1.PolicyTypeIngress, dst, src, dst, port, pols, nss)
+
+	return
+}
+
+// evalDirection checks the policies isolating target against traffic to/from peer.
+func evalDirection(dir netv1.PolicyType, target, peer, dst *v1.Pod, port string, pols []NetPolicy, nss map[string]labels.Set) render.NetVerdict {
+	var isolating []string
+	for _, np := range pols {
+		if np.Namespace != target.Namespace || !hasPolicyType(np, dir) {
+			continue
+		}
+		if !selectorMatches(&np.Spec.PodSelector, target.Labels) {
+			continue
+		}
+		fqn := client.MetaFQN(np.ObjectMeta)
+		isolating = append(isolating, fqn)
+		for i, r := range policyRules(np, dir) {
+			if !peersMatch(r.peers, np.Namespace, peer, nss) || !portsMatch(r.ports, port, dst) {
+				continue
+			}
+			return render.NetVerdict{
+				Allowed: true,
+				Policy:  fqn,
+				Rule:    fmt.Sprintf("%s[%d]", strings.ToLower(string(dir)), i),
+				Reason:  "rule matches " + r.describe(),
+			}
+		}
+	}
+
+	if len(isolating) == 0 {
+		return render.NetVerdict{
+			Allowed: true,
+			Policy:  render.MissingValue,
+			Rule:    render.MissingValue,
+			Reason:  fmt.Sprintf("pod is not isolated for %s", strings.ToLower(string(dir))),
+		}
+	}
+
+	return render.NetVerdict{
+		Policy: strings.Join(isolating, ","),
+		Rule:   render.MissingValue,
+		Reason: fmt.Sprintf("pod is isolated for %s and no rule matches", strings.ToLower(string(dir))),
+	}
+}
+
+type policyRule struct {
+	peers []netv1.NetworkPolicyPeer
+	ports []policyPort
+}
+
+type policyPort struct {
+	netv1.NetworkPolicyPort
+
+	endPort *int32
+}
+
+func (r policyRule) describe() string {
+	peers, ports := "all peers", "all ports"
+	if len(r.peers) > 0 {
+		peers = strconv.Itoa(len(r.peers)) + " peer(s)"
+	}
+	if len(r.ports) > 0 {
+		pp := make([]string, 0, len(r.ports))
+		for _, p := range r.ports {
+			s := "*"
+			if p.Port != nil {
+				s = p.Port.String()
+			}
+			if p.Port != nil && p.endPort != nil {
+				s += "-" + strconv.Itoa(int(*p.endPort))
+			}
+			pp = append(pp, s+"/"+string(protocol(p.Protocol)))
+		}
+		ports = strings.Join(pp, ",")
+	}
+
+	return peers + " on " + ports
+}
+
+func policyRules(np NetPolicy, dir netv1.PolicyType) []policyRule {
+	var rr []policyRule
+	if dir == netv1.PolicyTypeIngress {
+		for i, r := range np.Spec.Ingress {
+			rr = append(rr, policyRule{peers: r.From, ports: np.policyPorts(dir, i, r.Ports)})
+		}
+		return rr
+	}
+	for i, r := range np.Spec.Egress {
+		rr = append(rr, policyRule{peers: r.To, ports: np.policyPorts(dir, i, r.Ports)})
+	}
+
+	return rr
+}
+
+func (n NetPolicy) policyPorts(dir netv1.PolicyType, rule int, pp []netv1.NetworkPolicyPort) []policyPort {
+	res := make([]policyPort, 0, len(pp))
+	for i, p := range pp {
+		po := policyPort{NetworkPolicyPort: p}
+		if end, ok := n.EndPorts[EndPortKey(dir, rule, i)]; ok {
+			po.endPort = &end
+		}
+		res = append(res, po)
+	}
+
+	return res
+}
+
+// endPorts extracts the rules ports ranges upper bounds from a raw policy.
+func endPorts(o *unstructured.Unstructured) map[string]int32 {
+	mm := make(map[string]int32)
+	dirs := []struct {
+		dir   netv1.PolicyType
+		rules string
+	}{
+		{dir: netv1.PolicyTypeIngress, rules: "ingress"},
+		{dir: netv1.PolicyTypeEgress, rules: "egress"},
+	}
+	for _, d := range dirs {
+		rr, _, _ := unstructured.NestedSlice(o.Object, "spec", d.rules)
+		for i, r := range rr {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pp, _, _ := unstructured.NestedSlice(rule, "ports")
+			for j, p := range pp {
+				port, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if end, ok, _ := unstructured.NestedInt64(port, "endPort"); ok {
+					mm[EndPortKey(d.dir, i, j)] = int32(end)
+				}
+			}
+		}
+	}
+
+	return mm
+}
+
+// hasPolicyType checks the policy types. When unset, ingress is implied and
+// egress only applies if egress rules are present.
+func hasPolicyType(np NetPolicy, dir netv1.PolicyType) bool {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return dir == netv1.PolicyTypeIngress || len(np.Spec.Egress) > 0
+	}
+	for _, t := range np.Spec.PolicyTypes {
+		if t == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+func peersMatch(peers []netv1.NetworkPolicyPeer, polNS string, po *v1.Pod, nss map[string]labels.Set) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, p := range peers {
+		if p.IPBlock != nil {
+			if ipBlockMatches(p.IPBlock, po.Status.PodIP) {
+				return true
+			}
+			continue
+		}
+		if p.PodSelector == nil && p.NamespaceSelector == nil {
+			continue
+		}
+		nsOK := po.Namespace == polNS
+		if p.NamespaceSelector != nil {
+			nsOK = selectorMatches(p.NamespaceSelector, nss[po.Namespace])
+		}
+		podOK := p.PodSelector == nil || selectorMatches(p.PodSelector, po.Labels)
+		if nsOK && podOK {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ipBlockMatches(b *netv1.IPBlock, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(b.CIDR)
+	if err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, e := range b.Except {
+		if _, ex, err := net.ParseCIDR(e); err == nil && ex.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func portsMatch(pp []policyPort, port string, dst *v1.Pod) bool {
+	if len(pp) == 0 || port == "" {
+		return true
+	}
+	num, proto := resolvePort(port, dst)
+	for _, p := range pp {
+		if protocol(p.Protocol) != proto {
+			continue
+		}
+		if p.Port == nil {
+			return true
+		}
+		if p.Port.Type == intstr.Int && p.endPort != nil {
+			if start := int32(p.Port.IntValue()); num >= start && num <= *p.endPort {
+				return true
+			}
+			continue
+		}
+		if p.Port.Type == intstr.Int && int32(p.Port.IntValue()) == num {
+			return true
+		}
+		if p.Port.Type == intstr.String {
+			if p.Port.StrVal == port {
+				return true
+			}
+			if n, _ := resolvePort(p.Port.StrVal, dst); n != 0 && n == num {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// resolvePort returns a port number and protocol given a port number or name.
+func resolvePort(port string, po *v1.Pod) (int32, v1.Protocol) {
+	n, err := strconv.Atoi(port)
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			if (err == nil && p.ContainerPort == int32(n)) || p.Name == port {
+				return p.ContainerPort, protocol(&p.Protocol)
+			}
+		}
+	}
+	if err != nil {
+		return 0, v1.ProtocolTCP
+	}
+
+	return int32(n), v1.ProtocolTCP
+}
+
+func protocol(p *v1.Protocol) v1.Protocol {
+	if p == nil || *p == "" {
+		return v1.ProtocolTCP
+	}
+	return *p
+}
+
+func selectorMatches(s *metav1.LabelSelector, set labels.Set) bool {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return false
+	}
+	return sel.Matches(set)
+}
+
+// targetPort maps a service port to its target port.
+func targetPort(svc *v1.Service, port string) string {
+	if port == "" {
+		return port
+	}
+	for _, p := range svc.Spec.Ports {
+		if p.Name == port || strconv.Itoa(int(p.Port)) == port {
+			if p.TargetPort.String() == "0" || p.TargetPort.String() == "" {
+				return strconv.Itoa(int(p.Port))
+			}
+			return p.TargetPort.String()
+		}
+	}
+
+	return port
+}
@@ -0,0 +1,182 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestEvalNetPolicies(t *testing.T) {
+	src := makeNetPod("fe", "default", "10.0.0.1", map[string]string{"app": "fe"})
+	dst := makeNetPod("db", "data", "10.0.1.1", map[string]string{"app": "db"})
+	dst.Spec.Containers = []v1.Container{{Ports: []v1.ContainerPort{{Name: "pg", ContainerPort: 5432}}}}
+	nss := map[string]labels.Set{
+		"default": {"team": "web"},
+		"data":    {"team": "data"},
+	}
+
+	uu := map[string]struct {
+		pols            []dao.NetPolicy
+		port            string
+		egress, ingress bool
+		policy, rule    string
+		reason          string
+	}{
+		"no-policies": {
+			egress:  true,
+			ingress: true,
+			policy:  "<none>",
+			rule:    "<none>",
+		},
+		"deny-all": {
+			pols: []dao.NetPolicy{
+				makeNetPol("deny", "data", nil, netv1.NetworkPolicySpec{
+					PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+				}),
+			},
+			egress: true,
+			policy: "data/deny",
+			rule:   "<none>",
+		},
+		"ns-selector": {
+			pols: []dao.NetPolicy{
+				makeNetPol("allow-web", "data", map[string]string{"app": "db"}, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{
+							From:  []netv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}}}},
+							Ports: []netv1.NetworkPolicyPort{{Port: &intstr.IntOrString{Type: intstr.String, StrVal: "pg"}}},
+						},
+					},
+				}),
+			},
+			port:    "5432",
+			egress:  true,
+			ingress: true,
+			policy:  "data/allow-web",
+			rule:    "ingress[0]",
+		},
+		"wrong-port": {
+			pols: []dao.NetPolicy{
+				makeNetPol("allow-web", "data", nil, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{Ports: []netv1.NetworkPolicyPort{{Port: &intstr.IntOrString{IntVal: 80}}}},
+					},
+				}),
+			},
+			port:   "5432",
+			egress: true,
+			policy: "data/allow-web",
+			rule:   "<none>",
+		},
+		"pod-selector-other-ns": {
+			pols: []dao.NetPolicy{
+				makeNetPol("allow-fe", "data", nil, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{From: []netv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fe"}}}}},
+					},
+				}),
+			},
+			egress: true,
+			policy: "data/allow-fe",
+			rule:   "<none>",
+		},
+		"ip-block": {
+			pols: []dao.NetPolicy{
+				makeNetPol("allow-cidr", "data", nil, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{From: []netv1.NetworkPolicyPeer{{IPBlock: &netv1.IPBlock{CIDR: "10.0.0.0/16", Except: []string{"10.0.2.0/24"}}}}},
+					},
+				}),
+			},
+			egress:  true,
+			ingress: true,
+			policy:  "data/allow-cidr",
+			rule:    "ingress[0]",
+		},
+		"port-range": {
+			pols: []dao.NetPolicy{
+				withEndPort(makeNetPol("allow-range", "data", nil, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{Ports: []netv1.NetworkPolicyPort{{Port: &intstr.IntOrString{IntVal: 5000}}}},
+					},
+				}), 0, 0, 6000),
+			},
+			port:    "5432",
+			egress:  true,
+			ingress: true,
+			policy:  "data/allow-range",
+			rule:    "ingress[0]",
+			reason:  "rule matches all peers on 5000-6000/TCP",
+		},
+		"port-out-of-range": {
+			pols: []dao.NetPolicy{
+				withEndPort(makeNetPol("allow-range", "data", nil, netv1.NetworkPolicySpec{
+					Ingress: []netv1.NetworkPolicyIngressRule{
+						{Ports: []netv1.NetworkPolicyPort{{Port: &intstr.IntOrString{IntVal: 8000}}}},
+					},
+				}), 0, 0, 9000),
+			},
+			port:   "5432",
+			egress: true,
+			policy: "data/allow-range",
+			rule:   "<none>",
+		},
+		"egress-denied": {
+			pols: []dao.NetPolicy{
+				makeNetPol("lockdown", "default", nil, netv1.NetworkPolicySpec{
+					PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+					Egress: []netv1.NetworkPolicyEgressRule{
+						{To: []netv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}},
+					},
+				}),
+			},
+			ingress: true,
+			policy:  "<none>",
+			rule:    "<none>",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			egress, ingress := dao.EvalNetPolicies(src, dst, u.port, u.pols, nss)
+			assert.Equal(t, u.egress, egress.Allowed)
+			assert.Equal(t, u.ingress, ingress.Allowed)
+			assert.Equal(t, u.policy, ingress.Policy)
+			assert.Equal(t, u.rule, ingress.Rule)
+			if u.reason != "" {
+				assert.Equal(t, u.reason, ingress.Reason)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeNetPod(n, ns, ip string, ll map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns, Labels: ll},
+		Status:     v1.PodStatus{PodIP: ip},
+	}
+}
+
+func makeNetPol(n, ns string, sel map[string]string, spec netv1.NetworkPolicySpec) dao.NetPolicy {
+	spec.PodSelector = metav1.LabelSelector{MatchLabels: sel}
+	return dao.NetPolicy{
+		NetworkPolicy: netv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+			Spec:       spec,
+		},
+	}
+}
+
+func withEndPort(np dao.NetPolicy, rule, port int, end int32) dao.NetPolicy {
+	np.EndPorts = map[string]int32{dao.EndPortKey(netv1.PolicyTypeIngress, rule, port): end}
+	return np
+}
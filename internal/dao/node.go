@@ -127,6 +127,11 @@ func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return &render.NodeWithMetrics{Raw: u, MX: nmx}, nil
 }
 
+// CanWatch returns false as Node rows track pod counts.
+func (n *Node) CanWatch() bool {
+	return false
+}
+
 // List returns a collection of node resources.
 func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 
@@ -0,0 +1,168 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/render"
+	v1 "k8s.io/api/core/v1"
+	v1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PodDisruptionBudget)(nil)
+
+// PodDisruptionBudget represents a pod disruption budget and the pods it guards.
+type PodDisruptionBudget struct {
+	Resource
+}
+
+// CanWatch returns false as PDB rows are computed from the guarded pods.
+func (p *PodDisruptionBudget) CanWatch() bool {
+	return false
+}
+
+// Load returns a pod disruption budget instance.
+func (*PodDisruptionBudget) Load(f Factory, path string) (*v1beta1.PodDisruptionBudget, error) {
+	o, err := f.Get("policy/v1beta1/poddisruptionbudgets", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var pdb v1beta1.PodDisruptionBudget
+	if err := fromUnstructured(o, &pdb); err != nil {
+		return nil, err
+	}
+
+	return &pdb, nil
+}
+
+// List returns all budgets along with their guarded pods. When a node field
+// selector is set only the budgets blocking that node drain are listed.
+func (p *PodDisruptionBudget) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	sel, _ := ctx.Value(internal.KeyFields).(string)
+	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	if err != nil {
+		return nil, err
+	}
+	node := fsel["spec.nodeName"]
+
+	pods, err := p.pods(ns)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var pdb v1beta1.PodDisruptionBudget
+		if err := fromUnstructured(u, &pdb); err != nil {
+			return nil, err
+		}
+		guarded := GuardedPods(&pdb, pods)
+		if node != "" && !BlocksDrain(&pdb, guarded, node) {
+			continue
+		}
+		res = append(res, &render.PDBWithPods{
+			Raw:   u,
+			Pods:  podNames(guarded),
+			Nodes: podNodes(guarded),
+		})
+	}
+
+	return res, nil
+}
+
+func (p *PodDisruptionBudget) pods(ns string) ([]*v1.Pod, error) {
+	oo, err := p.Factory.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, &po)
+	}
+
+	return pp, nil
+}
+
+// GuardedPods returns the pods matching a budget selector in its namespace.
+// A nil selector matches no pods.
+func GuardedPods(pdb *v1beta1.PodDisruptionBudget, pods []*v1.Pod) []*v1.Pod {
+	if pdb.Spec.Selector == nil {
+		return nil
+	}
+	sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return nil
+	}
+	var res []*v1.Pod
+	for _, po := range pods {
+		if po.Namespace == pdb.Namespace && sel.Matches(labels.Set(po.Labels)) {
+			res = append(res, po)
+		}
+	}
+
+	return res
+}
+
+// BlocksDrain returns true if evicting the guarded pods running on the given
+// node would exceed the budget allowed disruptions.
+func BlocksDrain(pdb *v1beta1.PodDisruptionBudget, guarded []*v1.Pod, node string) bool {
+	var count int32
+	for _, po := range guarded {
+		if po.Spec.NodeName != node {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		count++
+	}
+
+	return count > 0 && count > pdb.Status.DisruptionsAllowed
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podNames(pods []*v1.Pod) []string {
+	nn := make([]string, 0, len(pods))
+	for _, po := range pods {
+		nn = append(nn, po.Name)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+func podNodes(pods []*v1.Pod) []string {
+	set := make(map[string]struct{}, len(pods))
+	for _, po := range pods {
+		if po.Spec.NodeName != "" {
+			set[po.Spec.NodeName] = struct{}{}
+		}
+	}
+	nn := make([]string, 0, len(set))
+	for n := range set {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
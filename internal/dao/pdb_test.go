@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	v1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGuardedPods(t *testing.T) {
+	pods := []*v1.Pod{
+		makePDBPod("default", "nginx-1", "n1", v1.PodRunning, "nginx"),
+		makePDBPod("default", "nginx-2", "n2", v1.PodRunning, "nginx"),
+		makePDBPod("ns1", "nginx-3", "n1", v1.PodRunning, "nginx"),
+		makePDBPod("default", "blee-1", "n1", v1.PodRunning, "blee"),
+	}
+
+	uu := map[string]struct {
+		sel *metav1.LabelSelector
+		e   []string
+	}{
+		"match": {
+			sel: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			e:   []string{"nginx-1", "nginx-2"},
+		},
+		"empty": {
+			sel: &metav1.LabelSelector{},
+			e:   []string{"nginx-1", "nginx-2", "blee-1"},
+		},
+		"none": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pdb := makePDB(u.sel, 0)
+			var nn []string
+			for _, po := range dao.GuardedPods(pdb, pods) {
+				nn = append(nn, po.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestBlocksDrain(t *testing.T) {
+	pods := []*v1.Pod{
+		makePDBPod("default", "nginx-1", "n1", v1.PodRunning, "nginx"),
+		makePDBPod("default", "nginx-2", "n1", v1.PodRunning, "nginx"),
+		makePDBPod("default", "nginx-3", "n2", v1.PodRunning, "nginx"),
+		makePDBPod("default", "nginx-4", "n3", v1.PodSucceeded, "nginx"),
+	}
+
+	uu := map[string]struct {
+		node    string
+		allowed int32
+		e       bool
+	}{
+		"blocked":      {node: "n1", allowed: 1, e: true},
+		"allowed":      {node: "n1", allowed: 2},
+		"zeroAllowed":  {node: "n2", e: true},
+		"completed":    {node: "n3"},
+		"noPodsOnNode": {node: "n4"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pdb := makePDB(&metav1.LabelSelector{}, u.allowed)
+			assert.Equal(t, u.e, dao.BlocksDrain(pdb, pods, u.node))
+		})
+	}
+}
+
+// Helpers...
+
+func makePDB(sel *metav1.LabelSelector, allowed int32) *v1beta1.PodDisruptionBudget {
+	return &v1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec:       v1beta1.PodDisruptionBudgetSpec{Selector: sel},
+		Status:     v1beta1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+	}
+}
+
+func makePDBPod(ns, n, node string, phase v1.PodPhase, app string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n, Labels: map[string]string{"app": app}},
+		Spec:       v1.PodSpec{NodeName: node},
+		Status:     v1.PodStatus{Phase: phase},
+	}
+}
@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Plugin)(nil)
+
+// PluginTimeout represents the max duration of a plugin run.
+const PluginTimeout = 30 * time.Second
+
+// PluginSpec describes a plugin command invocation.
+type PluginSpec struct {
+	Binary string
+	Args   []string
+}
+
+// PluginOutput represents a plugin output document. Table plugins emit
+// headers and rows, details plugins emit a text or YAML content.
+type PluginOutput struct {
+	Title   string             `json:"title"`
+	Headers []string           `json:"headers"`
+	Rows    []render.PluginRow `json:"rows"`
+	Format  string             `json:"format"`
+	Content string             `json:"content"`
+}
+
+// Plugin represents a plugin rendering its output as a table.
+type Plugin struct {
+	NonResource
+}
+
+// List runs the plugin command and returns its rows.
+func (p *Plugin) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	spec, ok := ctx.Value(internal.KeyPlugin).(PluginSpec)
+	if !ok {
+		return nil, fmt.Errorf("expecting a PluginSpec but got %T", ctx.Value(internal.KeyPlugin))
+	}
+
+	out, err := RunPlugin(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Headers) == 0 {
+		return nil, fmt.Errorf("plugin %q emitted no table headers", spec.Binary)
+	}
+	oo := make([]runtime.Object, 0, len(out.Rows))
+	for i, r := range out.Rows {
+		oo = append(oo, render.PluginRes{Header: out.Headers, Row: r, Index: i})
+	}
+
+	return oo, nil
+}
+
+// RunPlugin runs a plugin command and decodes its output. Output that is not
+// a JSON document is returned as text content.
+func RunPlugin(ctx context.Context, spec PluginSpec) (*PluginOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, PluginTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, spec.Binary, spec.Args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %q failed: %s", spec.Binary, msg)
+		}
+		return nil, fmt.Errorf("plugin %q failed: %s", spec.Binary, err)
+	}
+
+	return DecodePluginOutput(stdout.Bytes()), nil
+}
+
+// DecodePluginOutput decodes a plugin output document.
+func DecodePluginOutput(raw []byte) *PluginOutput {
+	var out PluginOutput
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return &PluginOutput{Format: "text", Content: string(raw)}
+	}
+
+	return &out
+}
@@ -0,0 +1,54 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePluginOutput(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   *dao.PluginOutput
+	}{
+		"table": {
+			raw: `{"headers": ["name", "status"], "rows": [{"id": "fred", "fields": ["fred", "ok"], "command": "pods"}]}`,
+			e: &dao.PluginOutput{
+				Headers: []string{"name", "status"},
+				Rows:    []render.PluginRow{{ID: "fred", Fields: []string{"fred", "ok"}, Command: "pods"}},
+			},
+		},
+		"details": {
+			raw: `{"title": "blee", "format": "yaml", "content": "a: b"}`,
+			e:   &dao.PluginOutput{Title: "blee", Format: "yaml", Content: "a: b"},
+		},
+		"text": {
+			raw: "hello\nworld",
+			e:   &dao.PluginOutput{Format: "text", Content: "hello\nworld"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.DecodePluginOutput([]byte(u.raw)))
+		})
+	}
+}
+
+func TestRunPlugin(t *testing.T) {
+	out, err := dao.RunPlugin(context.Background(), dao.PluginSpec{
+		Binary: "echo",
+		Args:   []string{`{"headers": ["name"], "rows": [{"fields": ["fred"]}]}`},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name"}, out.Headers)
+	assert.Equal(t, 1, len(out.Rows))
+
+	_, err = dao.RunPlugin(context.Background(), dao.PluginSpec{Binary: "false"})
+	assert.Error(t, err)
+}
@@ -113,6 +113,60 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return res, nil
 }
 
+// Decorate wraps an informer pod with its metrics or nil if filtered out.
+func (p *Pod) Decorate(ctx context.Context, o runtime.Object) (runtime.Object, error) {
+	o, err := p.Resource.Decorate(ctx, o)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	sel, _ := ctx.Value(internal.KeyFields).(string)
+	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	if err != nil {
+		return nil, err
+	}
+	if nodeName := fsel["spec.nodeName"]; nodeName != "" {
+		spec, ok := u.Object["spec"].(map[string]interface{})
+		if !ok || spec["nodeName"] != nodeName {
+			return nil, nil
+		}
+	}
+
+	var pmx *mv1beta1.PodMetrics
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		pmx, _ = client.DialMetrics(p.Client()).FetchPodMetrics(ctx, extractFQN(o))
+	}
+
+	return &render.PodWithMetrics{Raw: u, MX: pmx}, nil
+}
+
+// DecorateMetrics returns the informer pods in a given namespace wrapped with
+// their latest metrics.
+func (p *Pod) DecorateMetrics(ctx context.Context, ns string) ([]runtime.Object, error) {
+	pmx, err := client.DialMetrics(p.Client()).FetchPodsMetricsMap(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := p.Factory.List(p.gvr.String(), ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[extractFQN(u)]})
+	}
+
+	return res, nil
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
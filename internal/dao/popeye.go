@@ -59,7 +59,8 @@ func (p *Popeye) List(ctx context.Context, ns string) ([]runtime.Object, error)
 	flags.Output = &js
 	flags.ActiveNamespace = &ns
 
-	if report, ok := ctx.Value(internal.KeyPath).(string); ok && report != "" {
+	report, _ := ctx.Value(internal.KeyPath).(string)
+	if report != "" {
 		ns, n := client.Namespaced(report)
 		sections := []string{n}
 		flags.Sections = &sections
@@ -94,6 +95,10 @@ func (p *Popeye) List(ctx context.Context, ns string) ([]runtime.Object, error)
 		return nil, err
 	}
 
+	if report == "" {
+		p.saveRun(b.Report, ns)
+	}
+
 	oo := make([]runtime.Object, 0, len(b.Report.Sections))
 	sort.Sort(b.Report.Sections)
 	for _, s := range b.Report.Sections {
@@ -111,6 +116,26 @@ func (p *Popeye) Get(_ context.Context, _ string) (runtime.Object, error) {
 	return nil, errors.New("NYI!!")
 }
 
+// saveRun records a full sanitizer pass in the cluster history.
+func (p *Popeye) saveRun(r render.Report, ns string) {
+	cluster, err := p.Factory.Client().Config().CurrentClusterName()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Popeye history skipped")
+		return
+	}
+	run := render.PopeyeRun{
+		Timestamp: time.Now(),
+		Cluster:   cluster,
+		Namespace: client.CleanseNamespace(ns),
+		Score:     r.Score,
+		Grade:     r.Grade,
+		Sections:  r.Sections,
+	}
+	if _, err := SavePopeyeRun(PopeyeHistoryDir(cluster), &run); err != nil {
+		log.Warn().Err(err).Msgf("Popeye history save failed")
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -0,0 +1,262 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	cfg "github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// MaxPopeyeRuns represents the max number of sanitizer runs kept per cluster.
+	MaxPopeyeRuns = 100
+
+	// PopeyeRunTTL represents the max age of the latest run before an
+	// unchanged report gets persisted again.
+	PopeyeRunTTL = 24 * time.Hour
+
+	popeyeRunExt = ".json"
+)
+
+var (
+	_ Accessor = (*PopeyeHistory)(nil)
+	_ Nuker    = (*PopeyeHistory)(nil)
+	_ Accessor = (*PopeyeDiff)(nil)
+
+	invalidPathCharsRX = regexp.MustCompile(`[^\w.-]+`)
+)
+
+// PopeyeHistory tracks persisted sanitizer runs.
+type PopeyeHistory struct {
+	NonResource
+}
+
+// Delete nukes a resource.
+func (p *PopeyeHistory) Delete(path string, cascade, force bool) error {
+	return os.Remove(path)
+}
+
+// Get returns a resource.
+func (p *PopeyeHistory) Get(_ context.Context, path string) (runtime.Object, error) {
+	run, err := LoadPopeyeRun(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.PopeyeRunInfo{Path: path, Run: run}, nil
+}
+
+// List returns a collection of resources.
+func (p *PopeyeHistory) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		return nil, errors.New("no popeye history dir found in context")
+	}
+	runs, err := LoadPopeyeRuns(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(runs))
+	for _, r := range runs {
+		if !client.IsAllNamespaces(ns) && r.Run.Namespace != ns {
+			continue
+		}
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// PopeyeDiff tracks issues changes between two sanitizer runs.
+type PopeyeDiff struct {
+	NonResource
+}
+
+// Get returns a resource.
+func (p *PopeyeDiff) Get(context.Context, string) (runtime.Object, error) {
+	return nil, errors.New("NYI!!")
+}
+
+// List returns a collection of resources.
+func (p *PopeyeDiff) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, errors.New("no popeye run found in context")
+	}
+	base, ok := ctx.Value(internal.KeyBaseline).(string)
+	if !ok || base == "" {
+		return nil, errors.New("no popeye baseline run found in context")
+	}
+
+	from, err := LoadPopeyeRun(base)
+	if err != nil {
+		return nil, err
+	}
+	to, err := LoadPopeyeRun(path)
+	if err != nil {
+		return nil, err
+	}
+	dd := render.DiffPopeyeRuns(from, to)
+	oo := make([]runtime.Object, 0, len(dd))
+	for _, d := range dd {
+		oo = append(oo, d)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PopeyeHistoryDir returns the directory holding a cluster sanitizer runs.
+func PopeyeHistoryDir(cluster string) string {
+	return filepath.Join(cfg.OscHome(), "popeye", invalidPathCharsRX.ReplaceAllString(cluster, "-"))
+}
+
+// LoadPopeyeRun loads a persisted sanitizer run.
+func LoadPopeyeRun(path string) (*render.PopeyeRun, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var run render.PopeyeRun
+	if err := json.Unmarshal(raw, &run); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// LoadPopeyeRuns loads all the runs persisted in a directory, oldest first.
+// Each run tracks the previous run with the same scope.
+func LoadPopeyeRuns(dir string) ([]render.PopeyeRunInfo, error) {
+	ff, err := popeyeRunFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]render.PopeyeRunInfo, 0, len(ff))
+	for _, f := range ff {
+		path := filepath.Join(dir, f)
+		run, err := LoadPopeyeRun(path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping sanitizer run %q", path)
+			continue
+		}
+		runs = append(runs, render.PopeyeRunInfo{Path: path, Run: run})
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].Run.Timestamp.Before(runs[j].Run.Timestamp)
+	})
+	latest := make(map[string]*render.PopeyeRun)
+	for i := range runs {
+		ns := runs[i].Run.Namespace
+		runs[i].Previous = latest[ns]
+		latest[ns] = runs[i].Run
+	}
+
+	return runs, nil
+}
+
+// SavePopeyeRun persists a sanitizer run unless it reports the same issues as
+// the latest run with the same scope and that run is not stale. Oldest runs
+// are pruned past MaxPopeyeRuns.
+func SavePopeyeRun(dir string, run *render.PopeyeRun) (bool, error) {
+	ff, err := popeyeRunFiles(dir)
+	if err != nil {
+		return false, err
+	}
+	scope := popeyeRunScope(popeyeRunFile(run))
+	for i := len(ff) - 1; i >= 0; i-- {
+		if popeyeRunScope(ff[i]) != scope {
+			continue
+		}
+		path := filepath.Join(dir, ff[i])
+		last, err := LoadPopeyeRun(path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping sanitizer run %q", path)
+			continue
+		}
+		if run.Timestamp.Sub(last.Timestamp) < PopeyeRunTTL && sameRun(last, run) {
+			return false, nil
+		}
+		break
+	}
+
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return false, err
+	}
+	raw, err := json.Marshal(run)
+	if err != nil {
+		return false, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, popeyeRunFile(run)), raw, 0644); err != nil {
+		return false, err
+	}
+	for i := 0; i < len(ff)+1-MaxPopeyeRuns; i++ {
+		if err := os.Remove(filepath.Join(dir, ff[i])); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// popeyeRunFiles returns the runs file names persisted in a directory. Names
+// are prefixed with the run timestamp hence are listed oldest first.
+func popeyeRunFiles(dir string) ([]string, error) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	nn := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), popeyeRunExt) {
+			continue
+		}
+		nn = append(nn, f.Name())
+	}
+
+	return nn, nil
+}
+
+func popeyeRunFile(run *render.PopeyeRun) string {
+	n := run.ID()
+	if run.Namespace != "" {
+		n += "_" + invalidPathCharsRX.ReplaceAllString(run.Namespace, "-")
+	}
+
+	return n + popeyeRunExt
+}
+
+// popeyeRunScope returns the sanitized run namespace from a run file name.
+func popeyeRunScope(name string) string {
+	n := strings.TrimSuffix(name, popeyeRunExt)
+	if i := strings.Index(n, "_"); i >= 0 {
+		return n[i+1:]
+	}
+
+	return ""
+}
+
+func sameRun(r1, r2 *render.PopeyeRun) bool {
+	return r1.Score == r2.Score && len(render.DiffPopeyeRuns(r1, r2)) == 0
+}
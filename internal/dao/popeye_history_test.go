@@ -0,0 +1,127 @@
+package dao_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pcfg "github.com/derailed/popeye/pkg/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavePopeyeRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "osc-popeye")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	uu := []struct {
+		run   *render.PopeyeRun
+		saved bool
+	}{
+		{run: makePopeyeRun(t0, "", 80, "no probes"), saved: true},
+		{run: makePopeyeRun(t0.Add(time.Minute), "", 80, "no probes"), saved: false},
+		{run: makePopeyeRun(t0.Add(2*time.Minute), "default", 80, "no probes"), saved: true},
+		{run: makePopeyeRun(t0.Add(3*time.Minute), "", 90, "no limits"), saved: true},
+		{run: makePopeyeRun(t0.Add(dao.PopeyeRunTTL+3*time.Minute), "", 90, "no limits"), saved: true},
+	}
+	for i, u := range uu {
+		saved, err := dao.SavePopeyeRun(dir, u.run)
+		assert.Nil(t, err)
+		assert.Equal(t, u.saved, saved, "run %d", i)
+	}
+
+	runs, err := dao.LoadPopeyeRuns(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(runs))
+	assert.Nil(t, runs[0].Previous)
+	assert.Nil(t, runs[1].Previous)
+	assert.Equal(t, "default", runs[1].Run.Namespace)
+	assert.Equal(t, 80, runs[2].Previous.Score)
+	assert.Equal(t, 90, runs[3].Previous.Score)
+}
+
+func TestSavePopeyeRunPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "osc-popeye")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < dao.MaxPopeyeRuns+2; i++ {
+		_, err := dao.SavePopeyeRun(dir, makePopeyeRun(t0.Add(time.Duration(i)*time.Minute), "", i%100, "no probes"))
+		assert.Nil(t, err)
+	}
+
+	runs, err := dao.LoadPopeyeRuns(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, dao.MaxPopeyeRuns, len(runs))
+	assert.Equal(t, t0.Add(2*time.Minute), runs[0].Run.Timestamp.UTC())
+}
+
+func TestSavePopeyeRunLatestOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "osc-popeye")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	saved, err := dao.SavePopeyeRun(dir, makePopeyeRun(t0, "", 80, "no probes"))
+	assert.Nil(t, err)
+	assert.True(t, saved)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "20000101-000000.json"), []byte("blee"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "20300101-000000_fred.json"), []byte("blee"), 0644))
+	saved, err = dao.SavePopeyeRun(dir, makePopeyeRun(t0.Add(time.Minute), "", 80, "no probes"))
+	assert.Nil(t, err)
+	assert.False(t, saved)
+}
+
+func TestPopeyeRunsCorrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "osc-popeye")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	t0 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	saved, err := dao.SavePopeyeRun(dir, makePopeyeRun(t0, "", 80, "no probes"))
+	assert.Nil(t, err)
+	assert.True(t, saved)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "20300101-000000.json"), []byte("blee"), 0644))
+
+	saved, err = dao.SavePopeyeRun(dir, makePopeyeRun(t0.Add(time.Minute), "", 80, "no probes"))
+	assert.Nil(t, err)
+	assert.False(t, saved)
+
+	runs, err := dao.LoadPopeyeRuns(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(runs))
+	assert.Equal(t, t0, runs[0].Run.Timestamp.UTC())
+}
+
+func TestLoadPopeyeRunsNoDir(t *testing.T) {
+	runs, err := dao.LoadPopeyeRuns("/tmp/osc-popeye-blee")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(runs))
+}
+
+// Helpers...
+
+func makePopeyeRun(t time.Time, ns string, score int, msg string) *render.PopeyeRun {
+	return &render.PopeyeRun{
+		Timestamp: t,
+		Cluster:   "fred",
+		Namespace: ns,
+		Score:     score,
+		Sections: render.Sections{
+			{
+				Title: "pod",
+				Tally: &render.Tally{Warning: 1},
+				Outcome: render.Outcome{
+					"default/fred": render.Issues{{Group: "__root__", Level: pcfg.WarnLevel, Message: msg}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor  = (*PersistentVolume)(nil)
+	_ Reclaimer = (*PersistentVolume)(nil)
+)
+
+// PersistentVolume represents a persistent volume K8s resource.
+type PersistentVolume struct {
+	Resource
+}
+
+// Load returns a persistent volume instance.
+func (*PersistentVolume) Load(f Factory, path string) (*v1.PersistentVolume, error) {
+	o, err := f.Get("v1/persistentvolumes", client.FQN(client.ClusterScope, path), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var pv v1.PersistentVolume
+	if err := fromUnstructured(o, &pv); err != nil {
+		return nil, err
+	}
+
+	return &pv, nil
+}
+
+// Reclaim clears a released volume claim reference so it can be bound again.
+func (p *PersistentVolume) Reclaim(ctx context.Context, path string) error {
+	auth, err := p.Client().CanI(client.ClusterScope, "v1/persistentvolumes", []string{client.GetVerb, client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update persistentvolumes")
+	}
+
+	pv, err := p.Load(p.Factory, path)
+	if err != nil {
+		return err
+	}
+	if pv.Status.Phase != v1.VolumeReleased {
+		return fmt.Errorf("volume %s is %s. Only released volumes can be reclaimed", pv.Name, pv.Status.Phase)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().PersistentVolumes().Patch(
+		ctx,
+		pv.Name,
+		types.MergePatchType,
+		[]byte(`{"spec":{"claimRef":null}}`),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
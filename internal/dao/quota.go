@@ -0,0 +1,176 @@
+package dao
+
+import (
+	"context"
+	"sort"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Quota)(nil)
+
+// Quota represents a namespace quotas and limit ranges utilization.
+type Quota struct {
+	NonResource
+}
+
+// List returns a collection of quota utilization rows.
+func (q *Quota) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	qq, err := q.fetchQuotas(ns)
+	if err != nil {
+		return nil, err
+	}
+	lrs, err := q.fetchLimitRanges(ns)
+	if err != nil {
+		return nil, err
+	}
+	loads, err := q.podLoads(ns)
+	if err != nil {
+		return nil, err
+	}
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		q.podUsage(ctx, ns, loads)
+	}
+
+	res := make([]runtime.Object, 0, len(qq)*4)
+	seen := make(map[string]struct{}, len(qq))
+	for _, rq := range qq {
+		seen[rq.Namespace] = struct{}{}
+		load := loads[rq.Namespace]
+		for _, rn := range sortedResourceNames(rq.Status.Hard) {
+			res = append(res, render.QuotaRes{
+				Namespace:  rq.Namespace,
+				Name:       rq.Name,
+				Resource:   rn,
+				Hard:       rq.Status.Hard[rn],
+				Used:       rq.Status.Used[rn],
+				Load:       load,
+				LimitRange: lrs[rq.Namespace],
+				Age:        rq.CreationTimestamp,
+			})
+		}
+	}
+
+	// Namespaces governed by limit ranges only still surface their compute load.
+	for ns, lr := range lrs {
+		if _, ok := seen[ns]; ok {
+			continue
+		}
+		for _, rn := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			res = append(res, render.QuotaRes{
+				Namespace:  ns,
+				Resource:   rn,
+				Load:       loads[ns],
+				LimitRange: lr,
+				Age:        lr.CreationTimestamp,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (q *Quota) fetchQuotas(ns string) ([]v1.ResourceQuota, error) {
+	oo, err := q.Factory.List("v1/resourcequotas", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	qq := make([]v1.ResourceQuota, 0, len(oo))
+	for _, o := range oo {
+		var rq v1.ResourceQuota
+		if err := fromUnstructured(o, &rq); err != nil {
+			return nil, err
+		}
+		qq = append(qq, rq)
+	}
+
+	return qq, nil
+}
+
+// fetchLimitRanges returns the first limit range found in each namespace.
+func (q *Quota) fetchLimitRanges(ns string) (map[string]*v1.LimitRange, error) {
+	oo, err := q.Factory.List("v1/limitranges", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	lrs := make(map[string]*v1.LimitRange, len(oo))
+	for _, o := range oo {
+		var lr v1.LimitRange
+		if err := fromUnstructured(o, &lr); err != nil {
+			return nil, err
+		}
+		if _, ok := lrs[lr.Namespace]; !ok {
+			lrs[lr.Namespace] = &lr
+		}
+	}
+
+	return lrs, nil
+}
+
+// podLoads sums up active pods requests and limits per namespace.
+func (q *Quota) podLoads(ns string) (map[string]*render.NamespaceLoad, error) {
+	oo, err := q.Factory.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	loads := make(map[string]*render.NamespaceLoad)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if isTerminated(&po) {
+			continue
+		}
+		load, ok := loads[po.Namespace]
+		if !ok {
+			load = render.NewNamespaceLoad()
+			loads[po.Namespace] = load
+		}
+		load.AddPod(&po)
+	}
+
+	return loads, nil
+}
+
+func (q *Quota) podUsage(ctx context.Context, ns string, loads map[string]*render.NamespaceLoad) {
+	pmx, err := client.DialMetrics(q.Client()).FetchPodsMetrics(ctx, ns)
+	if err != nil {
+		return
+	}
+	for i := range pmx.Items {
+		mx := pmx.Items[i]
+		load, ok := loads[mx.Namespace]
+		if !ok {
+			continue
+		}
+		for _, co := range mx.Containers {
+			load.Usage.CPU.Add(*co.Usage.Cpu())
+			load.Usage.MEM.Add(*co.Usage.Memory())
+		}
+		load.HasMetrics = true
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sortedResourceNames(rl v1.ResourceList) []v1.ResourceName {
+	nn := make([]v1.ResourceName, 0, len(rl))
+	for n := range rl {
+		nn = append(nn, n)
+	}
+	sort.Slice(nn, func(i, j int) bool {
+		return nn[i] < nn[j]
+	})
+
+	return nn
+}
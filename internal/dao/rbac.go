@@ -31,6 +31,11 @@ type Rbac struct {
 	Resource
 }
 
+// CanWatch returns false as Rbac rows are computed from roles.
+func (r *Rbac) CanWatch() bool {
+	return false
+}
+
 // List lists out rbac resources.
 func (r *Rbac) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	gvr, ok := ctx.Value(internal.KeyGVR).(string)
@@ -24,6 +24,11 @@ type Policy struct {
 	Resource
 }
 
+// CanWatch returns false as Policy rows are computed from roles.
+func (p *Policy) CanWatch() bool {
+	return false
+}
+
 // List returns available policies.
 func (p *Policy) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
@@ -19,6 +19,11 @@ type Subject struct {
 	Resource
 }
 
+// CanWatch returns false as Subject rows are computed from bindings.
+func (s *Subject) CanWatch() bool {
+	return false
+}
+
 // List returns a collection of subjects.
 func (s *Subject) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
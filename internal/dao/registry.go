@@ -33,25 +33,38 @@ func NewMeta() *Meta {
 // Customize here for non resource types or types with metrics or logs.
 func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 	m := Accessors{
-		client.NewGVR("contexts"):                      &Context{},
-		client.NewGVR("containers"):                    &Container{},
-		client.NewGVR("screendumps"):                   &ScreenDump{},
-		client.NewGVR("benchmarks"):                    &Benchmark{},
-		client.NewGVR("portforwards"):                  &PortForward{},
-		client.NewGVR("v1/services"):                   &Service{},
-		client.NewGVR("v1/pods"):                       &Pod{},
-		client.NewGVR("v1/nodes"):                      &Node{},
-		client.NewGVR("apps/v1/deployments"):           &Deployment{},
-		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
-		client.NewGVR("extensions/v1beta1/daemonsets"): &DaemonSet{},
-		client.NewGVR("apps/v1/statefulsets"):          &StatefulSet{},
-		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
-		client.NewGVR("batch/v1/jobs"):                 &Job{},
-		client.NewGVR("openfaas"):                      &OpenFaas{},
-		client.NewGVR("popeye"):                        &Popeye{},
-		client.NewGVR("sanitizer"):                     &Popeye{},
-		client.NewGVR("helm"):                          &Helm{},
-		client.NewGVR("dir"):                           &Dir{},
+		client.NewGVR("contexts"):                            &Context{},
+		client.NewGVR("containers"):                          &Container{},
+		client.NewGVR("screendumps"):                         &ScreenDump{},
+		client.NewGVR("benchmarks"):                          &Benchmark{},
+		client.NewGVR("portforwards"):                        &PortForward{},
+		client.NewGVR("v1/services"):                         &Service{},
+		client.NewGVR("v1/pods"):                             &Pod{},
+		client.NewGVR("v1/nodes"):                            &Node{},
+		client.NewGVR("v1/persistentvolumes"):                &PersistentVolume{},
+		client.NewGVR("apps/v1/deployments"):                 &Deployment{},
+		client.NewGVR("apps/v1/daemonsets"):                  &DaemonSet{},
+		client.NewGVR("extensions/v1beta1/daemonsets"):       &DaemonSet{},
+		client.NewGVR("apps/v1/statefulsets"):                &StatefulSet{},
+		client.NewGVR("batch/v1beta1/cronjobs"):              &CronJob{},
+		client.NewGVR("batch/v1/jobs"):                       &Job{},
+		client.NewGVR("policy/v1beta1/poddisruptionbudgets"): &PodDisruptionBudget{},
+		client.NewGVR("openfaas"):                            &OpenFaas{},
+		client.NewGVR("popeye"):                              &Popeye{},
+		client.NewGVR("sanitizer"):                           &Popeye{},
+		client.NewGVR("popeyeruns"):                          &PopeyeHistory{},
+		client.NewGVR("popeyediffs"):                         &PopeyeDiff{},
+		client.NewGVR("helm"):                                &Helm{},
+		client.NewGVR("dir"):                                 &Dir{},
+		client.NewGVR("quotas"):                              &Quota{},
+		client.NewGVR("allocations"):                         &NodeAlloc{},
+		client.NewGVR("podallocations"):                      &PodAlloc{},
+		client.NewGVR("forensics"):                           &Forensic{},
+		client.NewGVR("netchecks"):                           &NetCheck{},
+		client.NewGVR("certs"):                               &Cert{},
+		client.NewGVR("routes"):                              &Route{},
+		client.NewGVR("storage"):                             &Storage{},
+		client.NewGVR("plugins"):                             &Plugin{},
 	}
 
 	r, ok := m[gvr]
@@ -194,6 +207,21 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("popeyeruns")] = metav1.APIResource{
+		Name:         "popeyeruns",
+		Kind:         "PopeyeRuns",
+		SingularName: "popeyerun",
+		Namespaced:   true,
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("popeyediffs")] = metav1.APIResource{
+		Name:         "popeyediffs",
+		Kind:         "PopeyeDiffs",
+		SingularName: "popeyediff",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("contexts")] = metav1.APIResource{
 		Name:         "contexts",
 		Kind:         "Contexts",
@@ -234,6 +262,73 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("allocations")] = metav1.APIResource{
+		Name:         "allocations",
+		Kind:         "Allocations",
+		SingularName: "allocation",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("podallocations")] = metav1.APIResource{
+		Name:         "podallocations",
+		Kind:         "PodAllocations",
+		SingularName: "podallocation",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("forensics")] = metav1.APIResource{
+		Name:         "forensics",
+		Kind:         "Forensics",
+		SingularName: "forensic",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("netchecks")] = metav1.APIResource{
+		Name:         "netchecks",
+		Kind:         "NetChecks",
+		SingularName: "netcheck",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("certs")] = metav1.APIResource{
+		Name:         "certs",
+		Kind:         "Certs",
+		SingularName: "cert",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("routes")] = metav1.APIResource{
+		Name:         "routes",
+		Kind:         "Routes",
+		SingularName: "route",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("storage")] = metav1.APIResource{
+		Name:         "storage",
+		Kind:         "Storage",
+		SingularName: "storage",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("plugins")] = metav1.APIResource{
+		Name:         "plugins",
+		Kind:         "Plugins",
+		SingularName: "plugin",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("quotas")] = metav1.APIResource{
+		Name:         "quotas",
+		Kind:         "Quotas",
+		SingularName: "quota",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/open-infra/osc/internal"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -13,6 +14,7 @@ var (
 	_ Accessor  = (*Resource)(nil)
 	_ Describer = (*Resource)(nil)
 	_ Nuker     = (*Resource)(nil)
+	_ Watchable = (*Resource)(nil)
 )
 
 // Resource represents an informer based resource.
@@ -33,6 +35,32 @@ func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error
 	return r.Factory.List(r.gvr.String(), ns, false, lsel)
 }
 
+// CanWatch returns true if the resource rows can be updated from informer events.
+func (r *Resource) CanWatch() bool {
+	return true
+}
+
+// Decorate returns an informer object as is unless filtered out by labels.
+func (r *Resource) Decorate(ctx context.Context, o runtime.Object) (runtime.Object, error) {
+	strLabel, _ := ctx.Value(internal.KeyLabels).(string)
+	if strLabel == "" {
+		return o, nil
+	}
+	sel, err := labels.Parse(strLabel)
+	if err != nil {
+		return o, nil
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	if !sel.Matches(labels.Set(m.GetLabels())) {
+		return nil, nil
+	}
+
+	return o, nil
+}
+
 // Get returns a resource instance if found, else an error.
 func (r *Resource) Get(_ context.Context, path string) (runtime.Object, error) {
 	return r.Factory.Get(r.gvr.String(), path, true, labels.Everything())
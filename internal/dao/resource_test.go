@@ -0,0 +1,44 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestResourceDecorate(t *testing.T) {
+	uu := map[string]struct {
+		labels string
+		e      bool
+	}{
+		"none": {
+			e: true,
+		},
+		"match": {
+			labels: "app=fred",
+			e:      true,
+		},
+		"nomatch": {
+			labels: "app=blee",
+		},
+	}
+
+	o := unstructured.Unstructured{}
+	o.SetName("fred")
+	o.SetLabels(map[string]string{"app": "fred"})
+	var r dao.Resource
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), internal.KeyLabels, u.labels)
+			d, err := r.Decorate(ctx, &o)
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, d != nil)
+		})
+	}
+}
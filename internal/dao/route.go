@@ -0,0 +1,253 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ Accessor = (*Route)(nil)
+
+// Route represents ingress routes to their backend services and pods.
+type Route struct {
+	NonResource
+}
+
+// List returns the routes of all ingresses with their backends health.
+func (r *Route) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := r.Factory.List("extensions/v1beta1/ingresses", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	svcs, err := r.services(ns)
+	if err != nil {
+		return nil, err
+	}
+	eps, err := r.endpoints(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		var ing v1beta1.Ingress
+		if err := fromUnstructured(o, &ing); err != nil {
+			return nil, err
+		}
+		for _, rr := range IngressRoutes(&ing, svcs, eps) {
+			res = append(res, rr)
+		}
+	}
+
+	return res, nil
+}
+
+// Get returns a given route.
+func (r *Route) Get(ctx context.Context, path string) (runtime.Object, error) {
+	ns, _ := client.Namespaced(path)
+	oo, err := r.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if rr, ok := o.(render.RouteRes); ok && rr.ID() == path {
+			return rr, nil
+		}
+	}
+
+	return nil, fmt.Errorf("route %q not found", path)
+}
+
+func (r *Route) services(ns string) (map[string]*v1.Service, error) {
+	oo, err := r.Factory.List("v1/services", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]*v1.Service, len(oo))
+	for _, o := range oo {
+		var svc v1.Service
+		if err := fromUnstructured(o, &svc); err != nil {
+			return nil, err
+		}
+		mm[client.MetaFQN(svc.ObjectMeta)] = &svc
+	}
+
+	return mm, nil
+}
+
+func (r *Route) endpoints(ns string) (map[string]*v1.Endpoints, error) {
+	oo, err := r.Factory.List("v1/endpoints", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]*v1.Endpoints, len(oo))
+	for _, o := range oo {
+		var ep v1.Endpoints
+		if err := fromUnstructured(o, &ep); err != nil {
+			return nil, err
+		}
+		mm[client.MetaFQN(ep.ObjectMeta)] = &ep
+	}
+
+	return mm, nil
+}
+
+// IngressRoutes expands an ingress host/paths into routes and checks the
+// backend services ports and endpoints. Services and endpoints are keyed
+// by fully qualified name.
+func IngressRoutes(ing *v1beta1.Ingress, svcs map[string]*v1.Service, eps map[string]*v1.Endpoints) []render.RouteRes {
+	tls := make(map[string]struct{})
+	for _, t := range ing.Spec.TLS {
+		for _, h := range t.Hosts {
+			tls[h] = struct{}{}
+		}
+	}
+	var address string
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if address = lb.IP; address == "" {
+			address = lb.Hostname
+		}
+		if address != "" {
+			break
+		}
+	}
+
+	var rr []render.RouteRes
+	add := func(host, path string, b v1beta1.IngressBackend) {
+		if host == "" {
+			host = "*"
+		}
+		if path == "" {
+			path = "/"
+		}
+		_, secure := tls[host]
+		route := render.RouteRes{
+			Namespace: ing.Namespace,
+			Ingress:   ing.Name,
+			Index:     len(rr),
+			Host:      host,
+			Path:      path,
+			TLS:       secure,
+			Address:   address,
+			Service:   b.ServiceName,
+			Port:      b.ServicePort.String(),
+			Created:   ing.CreationTimestamp,
+		}
+		checkRoute(&route, b, svcs, eps)
+		rr = append(rr, route)
+	}
+
+	if ing.Spec.Backend != nil {
+		add("", "", *ing.Spec.Backend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			add(rule.Host, p.Path, p.Backend)
+		}
+	}
+
+	return rr
+}
+
+// RouteBenchConfig returns a route benchmark configuration using the
+// backend service benchmark settings if any.
+func RouteBenchConfig(benchFile, svc string) config.BenchConfig {
+	def := config.DefaultBenchSpec()
+	cust, err := config.NewBench(benchFile)
+	if err != nil {
+		log.Debug().Msgf("No custom benchmark config file found")
+		return def
+	}
+	if b, ok := cust.Benchmarks.Services[svc]; ok {
+		b.HTTP.Host, b.HTTP.Path = "", ""
+		return b
+	}
+
+	def.C, def.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
+	return def
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func checkRoute(r *render.RouteRes, b v1beta1.IngressBackend, svcs map[string]*v1.Service, eps map[string]*v1.Endpoints) {
+	if b.ServiceName == "" {
+		r.Service, r.Port = render.MissingValue, render.MissingValue
+		r.Err = errors.New("no backend service")
+		return
+	}
+	svc, ok := svcs[r.ServiceFQN()]
+	if !ok {
+		r.Err = fmt.Errorf("service %s not found", b.ServiceName)
+		return
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		r.ExternalName, r.TargetPort = true, svc.Spec.ExternalName
+		return
+	}
+	port, ok := servicePort(svc, b.ServicePort)
+	if !ok {
+		r.Err = fmt.Errorf("port %s not exposed by service %s", b.ServicePort.String(), b.ServiceName)
+		return
+	}
+	r.TargetPort = port.TargetPort.String()
+
+	ep, ok := eps[r.ServiceFQN()]
+	if !ok {
+		return
+	}
+	for _, s := range ep.Subsets {
+		var found bool
+		for _, p := range s.Ports {
+			if p.Name == port.Name {
+				r.TargetPort, found = strconv.Itoa(int(p.Port)), true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		r.Ready = append(r.Ready, endpointNames(s.Addresses)...)
+		r.NotReady = append(r.NotReady, endpointNames(s.NotReadyAddresses)...)
+	}
+}
+
+func servicePort(svc *v1.Service, p intstr.IntOrString) (v1.ServicePort, bool) {
+	for _, sp := range svc.Spec.Ports {
+		if p.Type == intstr.Int && sp.Port == p.IntVal {
+			return sp, true
+		}
+		if p.Type == intstr.String && sp.Name == p.StrVal {
+			return sp, true
+		}
+	}
+
+	return v1.ServicePort{}, false
+}
+
+func endpointNames(aa []v1.EndpointAddress) []string {
+	ss := make([]string, 0, len(aa))
+	for _, a := range aa {
+		if a.TargetRef != nil && a.TargetRef.Kind == "Pod" {
+			ss = append(ss, a.TargetRef.Name)
+			continue
+		}
+		ss = append(ss, a.IP)
+	}
+
+	return ss
+}
@@ -0,0 +1,105 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestIngressRoutes(t *testing.T) {
+	ing := v1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec: v1beta1.IngressSpec{
+			Backend: &v1beta1.IngressBackend{ServiceName: "zorg", ServicePort: intstr.FromInt(80)},
+			TLS:     []v1beta1.IngressTLS{{Hosts: []string{"fred.io"}}},
+			Rules: []v1beta1.IngressRule{
+				{
+					Host: "fred.io",
+					IngressRuleValue: v1beta1.IngressRuleValue{HTTP: &v1beta1.HTTPIngressRuleValue{
+						Paths: []v1beta1.HTTPIngressPath{
+							{Path: "/api", Backend: v1beta1.IngressBackend{ServiceName: "blee", ServicePort: intstr.FromString("http")}},
+							{Path: "/admin", Backend: v1beta1.IngressBackend{ServiceName: "blee", ServicePort: intstr.FromInt(9090)}},
+							{Path: "/down", Backend: v1beta1.IngressBackend{ServiceName: "duh", ServicePort: intstr.FromInt(80)}},
+							{Path: "/ext", Backend: v1beta1.IngressBackend{ServiceName: "ext", ServicePort: intstr.FromInt(80)}},
+						},
+					}},
+				},
+			},
+		},
+		Status: v1beta1.IngressStatus{LoadBalancer: v1.LoadBalancerStatus{
+			Ingress: []v1.LoadBalancerIngress{{Hostname: "lb.fred.io"}},
+		}},
+	}
+	svcs := map[string]*v1.Service{
+		"default/blee": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "blee"},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("web")},
+			}},
+		},
+		"default/duh": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "duh"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080)}}},
+		},
+		"default/ext": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ext"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeExternalName, ExternalName: "ext.io"},
+		},
+	}
+	eps := map[string]*v1.Endpoints{
+		"default/blee": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "blee"},
+			Subsets: []v1.EndpointSubset{
+				{
+					Addresses: []v1.EndpointAddress{
+						{IP: "10.0.0.1", TargetRef: &v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "blee-1"}},
+					},
+					NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.2"}},
+					Ports:             []v1.EndpointPort{{Name: "http", Port: 8080}},
+				},
+				{
+					Addresses: []v1.EndpointAddress{{IP: "10.0.0.3"}},
+					Ports:     []v1.EndpointPort{{Name: "metrics", Port: 9090}},
+				},
+			},
+		},
+		"default/duh": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "duh"},
+			Subsets: []v1.EndpointSubset{
+				{
+					NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.4"}},
+					Ports:             []v1.EndpointPort{{Port: 8080}},
+				},
+			},
+		},
+	}
+
+	rr := dao.IngressRoutes(&ing, svcs, eps)
+	assert.Equal(t, 5, len(rr))
+
+	def := rr[0]
+	assert.Equal(t, "*", def.Host)
+	assert.Equal(t, "/", def.Path)
+	assert.Equal(t, "lb.fred.io", def.Address)
+	assert.Equal(t, "service zorg not found", def.Diagnose().Error())
+
+	api := rr[1]
+	assert.Equal(t, "default/fred:1", api.ID())
+	assert.True(t, api.TLS)
+	assert.Equal(t, "8080", api.TargetPort)
+	assert.Equal(t, []string{"blee-1"}, api.Ready)
+	assert.Equal(t, []string{"10.0.0.2"}, api.NotReady)
+	assert.Nil(t, api.Diagnose())
+
+	assert.Equal(t, "port 9090 not exposed by service blee", rr[2].Diagnose().Error())
+	assert.Equal(t, "no ready endpoints", rr[3].Diagnose().Error())
+	assert.Equal(t, "8080", rr[3].TargetPort)
+
+	assert.True(t, rr[4].ExternalName)
+	assert.Nil(t, rr[4].Diagnose())
+}
@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaField represents an OpenAPI schema field and its sub fields.
+type SchemaField struct {
+	Name        string
+	Path        string
+	Type        string
+	Description string
+	Default     string
+	Required    bool
+	Enum        []string
+	Fields      []*SchemaField
+}
+
+// NewSchemaField returns a field tree from an OpenAPI v3 json schema. Sub
+// fields paths are dotted and relative to the root field.
+func NewSchemaField(name string, schema map[string]interface{}) *SchemaField {
+	return newSchemaField(name, "", schema, false)
+}
+
+func newSchemaField(name, path string, schema map[string]interface{}, required bool) *SchemaField {
+	f := SchemaField{
+		Name:        name,
+		Path:        path,
+		Type:        schemaType(schema),
+		Description: schemaString(schema, "description"),
+		Required:    required,
+	}
+	if d, ok := schema["default"]; ok {
+		if raw, err := json.Marshal(d); err == nil {
+			f.Default = string(raw)
+		}
+	}
+	if ee, ok := schema["enum"].([]interface{}); ok {
+		for _, e := range ee {
+			f.Enum = append(f.Enum, fmt.Sprintf("%v", e))
+		}
+	}
+
+	body := schemaBody(schema)
+	props, _ := body["properties"].(map[string]interface{})
+	req := make(map[string]struct{})
+	if rr, ok := body["required"].([]interface{}); ok {
+		for _, r := range rr {
+			req[fmt.Sprintf("%v", r)] = struct{}{}
+		}
+	}
+	for _, k := range sortedKeys(props) {
+		s, ok := props[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		_, required := req[k]
+		f.Fields = append(f.Fields, newSchemaField(k, schemaPath(f.Path, k), s, required))
+	}
+
+	return &f
+}
+
+// Find returns the field at the given dotted path relative to this field.
+func (f *SchemaField) Find(path string) (*SchemaField, bool) {
+	if path == "" {
+		return f, true
+	}
+	tokens := strings.SplitN(path, ".", 2)
+	for _, c := range f.Fields {
+		if c.Name != tokens[0] {
+			continue
+		}
+		if len(tokens) == 1 {
+			return c, true
+		}
+		return c.Find(tokens[1])
+	}
+
+	return nil, false
+}
+
+// Count returns the number of fields in this tree.
+func (f *SchemaField) Count() int {
+	n := 1
+	for _, c := range f.Fields {
+		n += c.Count()
+	}
+
+	return n
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// schemaBody returns the schema describing sub fields for arrays and maps.
+func schemaBody(schema map[string]interface{}) map[string]interface{} {
+	switch {
+	case schema["type"] == "array":
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			return schemaBody(items)
+		}
+	case schema["properties"] == nil:
+		if vals, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			return schemaBody(vals)
+		}
+	}
+
+	return schema
+}
+
+func schemaType(schema map[string]interface{}) string {
+	if b, _ := schema["x-kubernetes-int-or-string"].(bool); b {
+		return "int-or-string"
+	}
+	t := schemaString(schema, "type")
+	switch t {
+	case "array":
+		items, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			return "[]object"
+		}
+		return "[]" + schemaType(items)
+	case "object", "":
+		if vals, ok := schema["additionalProperties"].(map[string]interface{}); ok && schema["properties"] == nil {
+			return "map[string]" + schemaType(vals)
+		}
+		return "object"
+	default:
+		return t
+	}
+}
+
+func schemaString(schema map[string]interface{}, key string) string {
+	s, _ := schema[key].(string)
+	return s
+}
+
+func schemaPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+func sortedKeys(mm map[string]interface{}) []string {
+	kk := make([]string, 0, len(mm))
+	for k := range mm {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func fooSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "object",
+		"description": "Foo is a test resource.",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"replicas"},
+				"properties": map[string]interface{}{
+					"replicas": map[string]interface{}{"type": "integer", "default": int64(1)},
+					"mode":     map[string]interface{}{"type": "string", "enum": []interface{}{"fast", "slow"}},
+					"port":     map[string]interface{}{"x-kubernetes-int-or-string": true},
+					"labels": map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": map[string]interface{}{"type": "string"},
+					},
+					"containers": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"name": map[string]interface{}{"type": "string"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSchemaField(t *testing.T) {
+	f := dao.NewSchemaField("Foo", fooSchema())
+
+	assert.Equal(t, "Foo", f.Name)
+	assert.Equal(t, "", f.Path)
+	assert.Equal(t, "object", f.Type)
+	assert.Equal(t, "Foo is a test resource.", f.Description)
+	assert.Equal(t, 8, f.Count())
+
+	uu := map[string]struct {
+		typ      string
+		required bool
+		def      string
+		enum     []string
+		fields   int
+	}{
+		"spec":                 {typ: "object", fields: 5},
+		"spec.replicas":        {typ: "integer", required: true, def: "1"},
+		"spec.mode":            {typ: "string", enum: []string{"fast", "slow"}},
+		"spec.port":            {typ: "int-or-string"},
+		"spec.labels":          {typ: "map[string]string"},
+		"spec.containers":      {typ: "[]object", fields: 1},
+		"spec.containers.name": {typ: "string"},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sf, ok := f.Find(k)
+			assert.True(t, ok)
+			assert.Equal(t, k, sf.Path)
+			assert.Equal(t, u.typ, sf.Type)
+			assert.Equal(t, u.required, sf.Required)
+			assert.Equal(t, u.def, sf.Default)
+			assert.Equal(t, u.enum, sf.Enum)
+			assert.Equal(t, u.fields, len(sf.Fields))
+		})
+	}
+}
+
+func TestSchemaFieldFind(t *testing.T) {
+	f := dao.NewSchemaField("Foo", fooSchema())
+
+	_, ok := f.Find("spec.blee")
+	assert.False(t, ok)
+	_, ok = f.Find("status")
+	assert.False(t, ok)
+	root, ok := f.Find("")
+	assert.True(t, ok)
+	assert.Equal(t, f, root)
+}
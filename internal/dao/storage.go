@@ -0,0 +1,326 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	volumeStatsTTL     = 30 * time.Second
+	volumeStatsTimeout = 3 * time.Second
+	volumeStatsWorkers = 5
+)
+
+var _ Accessor = (*Storage)(nil)
+
+var volumeStats = NewVolumeStats(volumeStatsTTL)
+
+// Storage represents volumes linked to their claims and mounting pods.
+type Storage struct {
+	NonResource
+}
+
+// List returns all volumes and unbound claims.
+func (s *Storage) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	pvs, err := s.Factory.List("v1/persistentvolumes", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.claims(ns)
+	if err != nil {
+		return nil, err
+	}
+	mounts, nodes, err := s.mounts(ns)
+	if err != nil {
+		return nil, err
+	}
+	usage := volumeStats.Usage(nodes, s.nodeSummary)
+
+	res := make([]runtime.Object, 0, len(pvs)+len(claims))
+	bound := make(map[string]struct{}, len(claims))
+	for _, o := range pvs {
+		var pv v1.PersistentVolume
+		if err := fromUnstructured(o, &pv); err != nil {
+			return nil, err
+		}
+		r := render.StorageRes{
+			Volume:       pv.Name,
+			PVPhase:      string(pv.Status.Phase),
+			Capacity:     -1,
+			Used:         -1,
+			StorageClass: pv.Spec.StorageClassName,
+			Reclaim:      string(pv.Spec.PersistentVolumeReclaimPolicy),
+			Created:      pv.CreationTimestamp,
+		}
+		if q, ok := pv.Spec.Capacity[v1.ResourceStorage]; ok {
+			r.Capacity = q.Value()
+		}
+		if ref := pv.Spec.ClaimRef; ref != nil {
+			if client.IsNamespaced(ns) && ref.Namespace != ns {
+				continue
+			}
+			r.Namespace, r.Claim = ref.Namespace, ref.Name
+			fqn := client.FQN(ref.Namespace, ref.Name)
+			if pvc, ok := claims[fqn]; ok && (ref.UID == "" || ref.UID == pvc.UID) {
+				r.PVCPhase, bound[fqn] = string(pvc.Status.Phase), struct{}{}
+			}
+			linkClaim(&r, fqn, mounts, usage)
+		} else if client.IsNamespaced(ns) {
+			continue
+		}
+		res = append(res, r)
+	}
+
+	for _, fqn := range sortedClaims(claims) {
+		if _, ok := bound[fqn]; ok {
+			continue
+		}
+		pvc := claims[fqn]
+		r := render.StorageRes{
+			Namespace: pvc.Namespace,
+			Claim:     pvc.Name,
+			Volume:    pvc.Spec.VolumeName,
+			PVCPhase:  string(pvc.Status.Phase),
+			Capacity:  -1,
+			Used:      -1,
+			Created:   pvc.CreationTimestamp,
+		}
+		if pvc.Spec.StorageClassName != nil {
+			r.StorageClass = *pvc.Spec.StorageClassName
+		}
+		if q, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+			r.Capacity = q.Value()
+		}
+		linkClaim(&r, fqn, mounts, usage)
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+func (s *Storage) claims(ns string) (map[string]*v1.PersistentVolumeClaim, error) {
+	oo, err := s.Factory.List("v1/persistentvolumeclaims", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]*v1.PersistentVolumeClaim, len(oo))
+	for _, o := range oo {
+		var pvc v1.PersistentVolumeClaim
+		if err := fromUnstructured(o, &pvc); err != nil {
+			return nil, err
+		}
+		mm[client.MetaFQN(pvc.ObjectMeta)] = &pvc
+	}
+
+	return mm, nil
+}
+
+// mounts maps claims to the pods mounting them and returns the pods nodes.
+func (s *Storage) mounts(ns string) (map[string][]string, []string, error) {
+	oo, err := s.Factory.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	mm, nn := make(map[string][]string), make(map[string]struct{})
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, nil, err
+		}
+		for _, vol := range po.Spec.Volumes {
+			if vol.PersistentVolumeClaim == nil {
+				continue
+			}
+			fqn := client.FQN(po.Namespace, vol.PersistentVolumeClaim.ClaimName)
+			mm[fqn] = append(mm[fqn], po.Name)
+			if po.Spec.NodeName != "" {
+				nn[po.Spec.NodeName] = struct{}{}
+			}
+		}
+	}
+	nodes := make([]string, 0, len(nn))
+	for n := range nn {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+
+	return mm, nodes, nil
+}
+
+func (s *Storage) nodeSummary(node string) ([]byte, error) {
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), volumeStatsTimeout)
+	defer cancel()
+
+	return dial.CoreV1().RESTClient().Get().
+		AbsPath("/api/v1/nodes", node, "proxy", "stats", "summary").
+		DoRaw(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// Volume stats...
+
+// VolumeUsage tracks a claim volume usage as reported by the kubelet.
+type VolumeUsage struct {
+	Used, Capacity int64
+}
+
+// SummaryFunc fetches a node kubelet stats summary.
+type SummaryFunc func(node string) ([]byte, error)
+
+type nodeVolumes struct {
+	usage map[string]VolumeUsage
+	at    time.Time
+}
+
+// VolumeStats caches claims volume usage per node.
+type VolumeStats struct {
+	ttl      time.Duration
+	nodes    map[string]nodeVolumes
+	inflight map[string]struct{}
+	mx       sync.Mutex
+}
+
+// NewVolumeStats returns a new volume stats cache.
+func NewVolumeStats(ttl time.Duration) *VolumeStats {
+	return &VolumeStats{
+		ttl:      ttl,
+		nodes:    make(map[string]nodeVolumes),
+		inflight: make(map[string]struct{}),
+	}
+}
+
+// Usage returns the cached claims volume usage for the given nodes. Stale
+// nodes summaries are refreshed in the background and show up on a later
+// call. Nodes which stats can not be fetched are skipped.
+func (v *VolumeStats) Usage(nodes []string, f SummaryFunc) map[string]VolumeUsage {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	res := make(map[string]VolumeUsage)
+	stale := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		nv, ok := v.nodes[n]
+		for k, u := range nv.usage {
+			res[k] = u
+		}
+		if ok && time.Since(nv.at) < v.ttl {
+			continue
+		}
+		if _, ok := v.inflight[n]; ok {
+			continue
+		}
+		v.inflight[n] = struct{}{}
+		stale = append(stale, n)
+	}
+	if len(stale) > 0 {
+		go v.refresh(stale, f)
+	}
+
+	return res
+}
+
+// refresh fetches the given nodes summaries using a bounded pool of workers.
+func (v *VolumeStats) refresh(nodes []string, f SummaryFunc) {
+	queue := make(chan string)
+	for i := 0; i < volumeStatsWorkers && i < len(nodes); i++ {
+		go func() {
+			for n := range queue {
+				usage, err := fetchVolumeUsage(n, f)
+				if err != nil {
+					log.Debug().Err(err).Msgf("Volume stats unavailable for node %q", n)
+				}
+				v.mx.Lock()
+				v.nodes[n] = nodeVolumes{usage: usage, at: time.Now()}
+				delete(v.inflight, n)
+				v.mx.Unlock()
+			}
+		}()
+	}
+	for _, n := range nodes {
+		queue <- n
+	}
+	close(queue)
+}
+
+func fetchVolumeUsage(node string, f SummaryFunc) (map[string]VolumeUsage, error) {
+	raw, err := f(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseVolumeUsage(raw)
+}
+
+// ParseVolumeUsage extracts claims volume usage from a kubelet stats summary.
+func ParseVolumeUsage(raw []byte) (map[string]VolumeUsage, error) {
+	var summary struct {
+		Pods []struct {
+			Volumes []struct {
+				PVCRef *struct {
+					Name      string `json:"name"`
+					Namespace string `json:"namespace"`
+				} `json:"pvcRef"`
+				UsedBytes     *uint64 `json:"usedBytes"`
+				CapacityBytes *uint64 `json:"capacityBytes"`
+			} `json:"volume"`
+		} `json:"pods"`
+	}
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, err
+	}
+
+	mm := make(map[string]VolumeUsage)
+	for _, p := range summary.Pods {
+		for _, vol := range p.Volumes {
+			if vol.PVCRef == nil || vol.UsedBytes == nil {
+				continue
+			}
+			u := VolumeUsage{Used: int64(*vol.UsedBytes), Capacity: -1}
+			if vol.CapacityBytes != nil {
+				u.Capacity = int64(*vol.CapacityBytes)
+			}
+			mm[client.FQN(vol.PVCRef.Namespace, vol.PVCRef.Name)] = u
+		}
+	}
+
+	return mm, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func linkClaim(r *render.StorageRes, fqn string, mounts map[string][]string, usage map[string]VolumeUsage) {
+	r.Pods = mounts[fqn]
+	u, ok := usage[fqn]
+	if !ok {
+		return
+	}
+	r.Used = u.Used
+	if r.Capacity < 0 {
+		r.Capacity = u.Capacity
+	}
+}
+
+func sortedClaims(mm map[string]*v1.PersistentVolumeClaim) []string {
+	kk := make([]string, 0, len(mm))
+	for k := range mm {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,110 @@
+package dao_test
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+const volumeSummary = `{
+  "node": {"nodeName": "n1"},
+  "pods": [
+    {
+      "podRef": {"name": "fred-0", "namespace": "default"},
+      "volume": [
+        {"name": "data", "usedBytes": 1024, "capacityBytes": 4096, "pvcRef": {"name": "fred", "namespace": "default"}},
+        {"name": "token", "usedBytes": 12}
+      ]
+    },
+    {
+      "podRef": {"name": "blee-0", "namespace": "ns1"},
+      "volume": [
+        {"name": "data", "pvcRef": {"name": "blee", "namespace": "ns1"}}
+      ]
+    }
+  ]
+}`
+
+func TestParseVolumeUsage(t *testing.T) {
+	mm, err := dao.ParseVolumeUsage([]byte(volumeSummary))
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]dao.VolumeUsage{
+		"default/fred": {Used: 1024, Capacity: 4096},
+	}, mm)
+
+	_, err = dao.ParseVolumeUsage([]byte("{"))
+	assert.NotNil(t, err)
+}
+
+func TestVolumeStatsUsage(t *testing.T) {
+	var calls int32
+	f := func(node string) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		if node == "n2" {
+			return nil, errors.New("forbidden")
+		}
+		return []byte(volumeSummary), nil
+	}
+
+	s := dao.NewVolumeStats(time.Minute)
+	assert.Equal(t, 0, len(s.Usage([]string{"n1", "n2"}, f)))
+	assert.Eventually(t, func() bool {
+		return len(s.Usage([]string{"n1", "n2"}, f)) == 1
+	}, time.Second, 10*time.Millisecond)
+	mm := s.Usage([]string{"n1", "n2"}, f)
+	assert.Equal(t, dao.VolumeUsage{Used: 1024, Capacity: 4096}, mm["default/fred"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestVolumeStatsUsageInflight(t *testing.T) {
+	var calls int32
+	block := make(chan struct{})
+	f := func(node string) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		<-block
+		return []byte(volumeSummary), nil
+	}
+
+	s := dao.NewVolumeStats(time.Minute)
+	s.Usage([]string{"n1"}, f)
+	s.Usage([]string{"n1"}, f)
+	close(block)
+	assert.Eventually(t, func() bool {
+		return len(s.Usage([]string{"n1"}, f)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestVolumeStatsUsageWorkers(t *testing.T) {
+	var calls, active, max int32
+	f := func(node string) ([]byte, error) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&calls, 1)
+		return []byte(volumeSummary), nil
+	}
+
+	nodes := make([]string, 20)
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf("n%d", i)
+	}
+	s := dao.NewVolumeStats(time.Minute)
+	s.Usage(nodes, f)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == int32(len(nodes))
+	}, time.Second, 10*time.Millisecond)
+	assert.LessOrEqual(t, atomic.LoadInt32(&max), int32(5))
+}
@@ -0,0 +1,113 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ TaintPatcher = (*Node)(nil)
+
+// TaintRows returns a collection of `key=value:Effect` taint rows.
+func TaintRows(tt []v1.Taint) []string {
+	rr := make([]string, 0, len(tt))
+	for _, t := range tt {
+		rr = append(rr, TaintRow(t))
+	}
+
+	return rr
+}
+
+// TaintRow returns a taint as `key=value:Effect`.
+func TaintRow(t v1.Taint) string {
+	if t.Value == "" {
+		return t.Key + ":" + string(t.Effect)
+	}
+
+	return t.Key + "=" + t.Value + ":" + string(t.Effect)
+}
+
+// ParseTaints parses a collection of `key[=value]:Effect` rows. Blank rows are skipped.
+func ParseTaints(rr []string) ([]v1.Taint, error) {
+	tt := make([]v1.Taint, 0, len(rr))
+	for _, r := range rr {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		t, err := ParseTaint(r)
+		if err != nil {
+			return nil, err
+		}
+		tt = append(tt, t)
+	}
+
+	return tt, nil
+}
+
+// ParseTaint parses a `key[=value]:Effect` taint.
+func ParseTaint(s string) (v1.Taint, error) {
+	var t v1.Taint
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return t, fmt.Errorf("invalid taint %q, expecting key[=value]:Effect", s)
+	}
+	kv, effect := s[:i], v1.TaintEffect(s[i+1:])
+	switch effect {
+	case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+	default:
+		return t, fmt.Errorf("invalid taint effect %q", effect)
+	}
+	tokens := strings.SplitN(kv, "=", 2)
+	t.Key, t.Effect = tokens[0], effect
+	if errs := validation.IsQualifiedName(t.Key); len(errs) > 0 {
+		return t, fmt.Errorf("invalid taint key %q: %s", t.Key, strings.Join(errs, ", "))
+	}
+	if len(tokens) == 2 {
+		t.Value = tokens[1]
+	}
+
+	return t, nil
+}
+
+// CarryTaintTimes preserves the time the existing NoExecute taints were added
+// so their tolerations clocks are not reset when the taints list is replaced.
+func CarryTaintTimes(current, tt []v1.Taint) []v1.Taint {
+	res := make([]v1.Taint, len(tt))
+	for i, t := range tt {
+		res[i] = t
+		if t.Effect != v1.TaintEffectNoExecute || t.TimeAdded != nil {
+			continue
+		}
+		for _, c := range current {
+			if c.Key == t.Key && c.Effect == t.Effect {
+				res[i].TimeAdded = c.TimeAdded
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// PatchTaints replaces a node taints.
+func (n *Node) PatchTaints(ctx context.Context, path string, tt []v1.Taint) error {
+	no, err := FetchNode(ctx, n.Factory, path)
+	if err != nil {
+		return err
+	}
+	tt = CarryTaintTimes(no.Spec.Taints, tt)
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"taints": tt},
+	})
+	if err != nil {
+		return err
+	}
+
+	return n.mergePatch(ctx, path, raw)
+}
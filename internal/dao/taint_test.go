@@ -0,0 +1,80 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseTaint(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   v1.Taint
+		err bool
+	}{
+		"full": {
+			s: "dedicated=gpu:NoSchedule",
+			e: v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		},
+		"no-value": {
+			s: "osc.io/maintenance:NoExecute",
+			e: v1.Taint{Key: "osc.io/maintenance", Effect: v1.TaintEffectNoExecute},
+		},
+		"no-effect": {
+			s:   "dedicated=gpu",
+			err: true,
+		},
+		"bad-effect": {
+			s:   "dedicated=gpu:Never",
+			err: true,
+		},
+		"bad-key": {
+			s:   "=gpu:NoSchedule",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta, err := dao.ParseTaint(u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ta)
+			assert.Equal(t, u.s, dao.TaintRow(ta))
+		})
+	}
+}
+
+func TestParseTaints(t *testing.T) {
+	tt, err := dao.ParseTaints([]string{"a=b:NoSchedule", " ", "c:PreferNoSchedule"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tt))
+	assert.Equal(t, []string{"a=b:NoSchedule", "c:PreferNoSchedule"}, dao.TaintRows(tt))
+}
+
+func TestCarryTaintTimes(t *testing.T) {
+	added := metav1.NewTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	current := []v1.Taint{
+		{Key: "a", Value: "b", Effect: v1.TaintEffectNoExecute, TimeAdded: &added},
+		{Key: "c", Effect: v1.TaintEffectNoSchedule},
+	}
+	tt, err := dao.ParseTaints([]string{"a=z:NoExecute", "a:NoSchedule", "c:NoExecute", "c:NoSchedule"})
+	assert.Nil(t, err)
+
+	res := dao.CarryTaintTimes(current, tt)
+	assert.Equal(t, 4, len(res))
+	assert.Equal(t, &added, res[0].TimeAdded)
+	for _, r := range res[1:] {
+		assert.Nil(t, r.TimeAdded)
+	}
+	assert.Nil(t, tt[0].TimeAdded)
+}
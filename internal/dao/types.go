@@ -72,6 +72,34 @@ type Accessor interface {
 	GVR() string
 }
 
+// Watchable represents a resource supporting informer based incremental
+// updates.
+type Watchable interface {
+	// CanWatch returns true if the resource rows can be updated from
+	// informer events.
+	CanWatch() bool
+
+	// Decorate converts an informer object into a renderable object or nil
+	// if the object should not be listed.
+	Decorate(ctx context.Context, o runtime.Object) (runtime.Object, error)
+}
+
+// MetricsDecorator represents a watchable resource which rows carry metrics.
+type MetricsDecorator interface {
+	// DecorateMetrics returns the informer resources in a given namespace
+	// along with their latest metrics.
+	DecorateMetrics(ctx context.Context, ns string) ([]runtime.Object, error)
+}
+
+// EventSource represents a source of resource informer events.
+type EventSource interface {
+	// AddListener registers a resource events listener.
+	AddListener(ns, gvr string, l watch.ResourceListener) error
+
+	// RemoveListener unregisters a resource events listener.
+	RemoveListener(ns, gvr string, l watch.ResourceListener)
+}
+
 // DrainOptions tracks drain attributes.
 type DrainOptions struct {
 	GracePeriodSeconds  int
@@ -129,18 +157,75 @@ type Switchable interface {
 	Switch(ctx string) error
 }
 
+// KubeConfigurable represents a kubeconfig contexts manager.
+type KubeConfigurable interface {
+	// Rename renames a context.
+	Rename(n, newName string) error
+
+	// Duplicate copies a context under a new name.
+	Duplicate(n, dupName string) error
+
+	// SetNamespace sets a context default namespace.
+	SetNamespace(n, ns string) error
+
+	// Import merges a kubeconfig file and returns the imported contexts.
+	Import(path string) ([]string, error)
+}
+
 // Restartable represents a restartable resource.
 type Restartable interface {
 	// Restart performs a rollout restart.
 	Restart(ctx context.Context, path string) error
 }
 
+// MetaPatcher represents a resource with patchable labels and annotations.
+type MetaPatcher interface {
+	// PatchMeta merges labels and annotations updates.
+	PatchMeta(ctx context.Context, path string, p MetaPatch) error
+}
+
+// TaintPatcher represents a resource with patchable taints.
+type TaintPatcher interface {
+	// PatchTaints replaces taints.
+	PatchTaints(ctx context.Context, path string, tt []v1.Taint) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
 	Run(path string) error
 }
 
+// OverridableRunner represents a runnable resource supporting container
+// overrides.
+type OverridableRunner interface {
+	Runnable
+
+	// RunWith triggers a run with the given overrides.
+	RunWith(path string, oo JobOverrides) error
+}
+
+// Suspendable represents a resource that can be suspended.
+type Suspendable interface {
+	// ToggleSuspend suspends or resumes a resource.
+	ToggleSuspend(ctx context.Context, path string) error
+}
+
+// Reclaimer represents a resource which released instances can be reclaimed.
+type Reclaimer interface {
+	// Reclaim makes a released resource available again.
+	Reclaim(ctx context.Context, path string) error
+}
+
+// Pruner represents a resource which finished instances can be pruned.
+type Pruner interface {
+	Nuker
+
+	// Prunable returns the given resources that finished more than a given
+	// age ago.
+	Prunable(paths []string, age time.Duration) ([]string, error)
+}
+
 // Logger represents a resource that exposes logs.
 type Logger interface {
 	// Logs tails a resource logs.
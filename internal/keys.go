@@ -30,4 +30,8 @@ const (
 	KeyWithMetrics ContextKey = "withMetrics"
 	KeyViewConfig  ContextKey = "viewConfig"
 	KeyWait        ContextKey = "wait"
+	KeyNetCheck    ContextKey = "netcheck"
+	KeyPlugin      ContextKey = "plugin"
+	KeyAccessible  ContextKey = "accessible"
+	KeyBaseline    ContextKey = "baseline"
 )
@@ -0,0 +1,213 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MaxHPASamples represents the max number of samples kept per HPA.
+const MaxHPASamples = 500
+
+// hpaHistory tracks HPAs scaling samples for the session, keyed by context
+// and HPA path.
+var hpaHistory = NewHPAHistory(MaxHPASamples)
+
+// SampleHPA records an HPA scaling state for a given context.
+func SampleHPA(contextName string, st *dao.HPAStatus) {
+	hpaHistory.Add(hpaKey(contextName, st.Path), newHPASample(st))
+}
+
+// HPASampler records the listed HPAs scaling states.
+type HPASampler struct{}
+
+// Sample records the given HPAs scaling states for a given context.
+func (HPASampler) Sample(contextName string, oo []runtime.Object) {
+	for _, o := range oo {
+		st, err := dao.NewHPAStatus(o)
+		if err != nil {
+			log.Warn().Err(err).Msgf("HPA sample failed")
+			continue
+		}
+		SampleHPA(contextName, st)
+	}
+}
+
+// HPASample tracks an HPA scaling state at a point in time.
+type HPASample struct {
+	At                time.Time
+	Replicas, Desired int32
+	Metrics           []dao.HPAMetric
+}
+
+// HPAHistory tracks HPAs scaling samples.
+type HPAHistory struct {
+	max     int
+	samples map[string][]HPASample
+	mx      sync.RWMutex
+}
+
+// NewHPAHistory returns a new history.
+func NewHPAHistory(max int) *HPAHistory {
+	return &HPAHistory{
+		max:     max,
+		samples: make(map[string][]HPASample),
+	}
+}
+
+// Add records a sample and returns the HPA samples.
+func (h *HPAHistory) Add(path string, s HPASample) []HPASample {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss := append(h.samples[path], s)
+	if len(ss) > h.max {
+		ss = ss[len(ss)-h.max:]
+	}
+	h.samples[path] = ss
+
+	return append([]HPASample(nil), ss...)
+}
+
+// Samples returns an HPA samples.
+func (h *HPAHistory) Samples(path string) []HPASample {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return append([]HPASample(nil), h.samples[path]...)
+}
+
+// HPAListener represents an HPA model listener.
+type HPAListener interface {
+	// HPAChanged notifies the HPA state changed.
+	HPAChanged(*dao.HPAStatus, []HPASample)
+
+	// HPAFailed notifies the HPA load failed.
+	HPAFailed(error)
+}
+
+// HPA tracks an HPA scaling behavior.
+type HPA struct {
+	path, key   string
+	inUpdate    int32
+	listeners   []HPAListener
+	refreshRate time.Duration
+	history     *HPAHistory
+}
+
+// NewHPA returns a new HPA model for a given context.
+func NewHPA(contextName, path string) *HPA {
+	return &HPA{
+		path:        path,
+		key:         hpaKey(contextName, path),
+		refreshRate: defaultRefreshRate,
+		history:     hpaHistory,
+	}
+}
+
+// Samples returns the HPA session samples.
+func (h *HPA) Samples() []HPASample {
+	return h.history.Samples(h.key)
+}
+
+// Watch monitors the HPA.
+func (h *HPA) Watch(ctx context.Context) {
+	h.refresh(ctx)
+	go h.updater(ctx)
+}
+
+func (h *HPA) updater(ctx context.Context) {
+	defer log.Debug().Msgf("HPA canceled -- %q", h.path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.refreshRate):
+			h.refresh(ctx)
+		}
+	}
+}
+
+func (h *HPA) refresh(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&h.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping update...")
+		return
+	}
+	defer atomic.StoreInt32(&h.inUpdate, 0)
+
+	st, err := h.status(ctx)
+	if err != nil {
+		log.Error().Err(err).Msgf("HPA status %q", h.path)
+		h.fireHPAFailed(err)
+		return
+	}
+	h.fireHPAChanged(st, h.history.Add(h.key, newHPASample(st)))
+}
+
+func (h *HPA) status(ctx context.Context) (*dao.HPAStatus, error) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+	var hpa dao.HorizontalPodAutoscaler
+	hpa.Init(f, client.NewGVR("autoscaling/v1/horizontalpodautoscalers"))
+
+	return hpa.Status(h.path)
+}
+
+// AddListener adds a listener.
+func (h *HPA) AddListener(l HPAListener) {
+	h.listeners = append(h.listeners, l)
+}
+
+// RemoveListener delete a listener.
+func (h *HPA) RemoveListener(l HPAListener) {
+	victim := -1
+	for i, lis := range h.listeners {
+		if lis == l {
+			victim = i
+			break
+		}
+	}
+
+	if victim >= 0 {
+		h.listeners = append(h.listeners[:victim], h.listeners[victim+1:]...)
+	}
+}
+
+func (h *HPA) fireHPAChanged(st *dao.HPAStatus, ss []HPASample) {
+	for _, l := range h.listeners {
+		l.HPAChanged(st, ss)
+	}
+}
+
+func (h *HPA) fireHPAFailed(err error) {
+	for _, l := range h.listeners {
+		l.HPAFailed(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaKey(contextName, path string) string {
+	return contextName + ":" + path
+}
+
+func newHPASample(st *dao.HPAStatus) HPASample {
+	return HPASample{
+		At:       time.Now(),
+		Replicas: st.CurrentReplicas,
+		Desired:  st.DesiredReplicas,
+		Metrics:  st.Metrics,
+	}
+}
@@ -0,0 +1,68 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHPAHistoryAdd(t *testing.T) {
+	h := model.NewHPAHistory(3)
+	for i := 1; i <= 5; i++ {
+		h.Add("default/fred", model.HPASample{Replicas: int32(i)})
+	}
+	h.Add("default/blee", model.HPASample{Replicas: 10})
+
+	ss := h.Samples("default/fred")
+	assert.Equal(t, 3, len(ss))
+	assert.Equal(t, int32(3), ss[0].Replicas)
+	assert.Equal(t, int32(5), ss[2].Replicas)
+	assert.Equal(t, 1, len(h.Samples("default/blee")))
+	assert.Equal(t, 0, len(h.Samples("default/zorg")))
+}
+
+func TestHPAHistorySamplesCopy(t *testing.T) {
+	h := model.NewHPAHistory(3)
+	ss := h.Add("default/fred", model.HPASample{Replicas: 1})
+	ss[0].Replicas = 10
+
+	assert.Equal(t, int32(1), h.Samples("default/fred")[0].Replicas)
+}
+
+func TestSampleHPA(t *testing.T) {
+	model.SampleHPA("ct1", &dao.HPAStatus{Path: "default/fred", CurrentReplicas: 2})
+	model.SampleHPA("ct2", &dao.HPAStatus{Path: "default/fred", CurrentReplicas: 5})
+
+	ss := model.NewHPA("ct1", "default/fred").Samples()
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, int32(2), ss[0].Replicas)
+	assert.Equal(t, int32(5), model.NewHPA("ct2", "default/fred").Samples()[0].Replicas)
+	assert.Equal(t, 0, len(model.NewHPA("ct3", "default/fred").Samples()))
+}
+
+func TestHPASamplerSample(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v1",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "fred"},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"kind": "Deployment", "name": "fred"},
+			"maxReplicas":    int64(10),
+		},
+		"status": map[string]interface{}{
+			"currentReplicas": int64(3),
+			"desiredReplicas": int64(4),
+		},
+	}}
+
+	model.HPASampler{}.Sample("ct4", []runtime.Object{o})
+
+	ss := model.NewHPA("ct4", "default/fred").Samples()
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, int32(3), ss[0].Replicas)
+	assert.Equal(t, int32(4), ss[0].Desired)
+}
@@ -74,6 +74,50 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Popeye{},
 		Renderer: &render.Popeye{},
 	},
+	"popeyeruns": {
+		DAO:      &dao.PopeyeHistory{},
+		Renderer: &render.PopeyeHistory{},
+	},
+	"popeyediffs": {
+		DAO:      &dao.PopeyeDiff{},
+		Renderer: &render.PopeyeDiff{},
+	},
+	"quotas": {
+		DAO:      &dao.Quota{},
+		Renderer: &render.Quota{},
+	},
+	"allocations": {
+		DAO:      &dao.NodeAlloc{},
+		Renderer: &render.NodeAlloc{},
+	},
+	"podallocations": {
+		DAO:      &dao.PodAlloc{},
+		Renderer: &render.PodAlloc{},
+	},
+	"forensics": {
+		DAO:      &dao.Forensic{},
+		Renderer: &render.Forensic{},
+	},
+	"netchecks": {
+		DAO:      &dao.NetCheck{},
+		Renderer: &render.NetCheck{},
+	},
+	"certs": {
+		DAO:      &dao.Cert{},
+		Renderer: &render.Cert{},
+	},
+	"routes": {
+		DAO:      &dao.Route{},
+		Renderer: &render.Route{},
+	},
+	"storage": {
+		DAO:      &dao.Storage{},
+		Renderer: &render.Storage{},
+	},
+	"plugins": {
+		DAO:      &dao.Plugin{},
+		Renderer: &render.Plugin{},
+	},
 	"sanitizer": {
 		DAO:          &dao.Popeye{},
 		TreeRenderer: &xray.Section{},
@@ -107,6 +151,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.ServiceAccount{},
 	},
 	"v1/persistentvolumes": {
+		DAO:      &dao.PersistentVolume{},
 		Renderer: &render.PersistentVolume{},
 	},
 	"v1/persistentvolumeclaims": {
@@ -162,14 +207,17 @@ var Registry = map[string]ResourceMeta{
 	"autoscaling/v1/horizontalpodautoscalers": {
 		DAO:      &dao.HorizontalPodAutoscaler{},
 		Renderer: &render.HorizontalPodAutoscaler{},
+		Sampler:  HPASampler{},
 	},
 	"autoscaling/v2beta1/horizontalpodautoscalers": {
 		DAO:      &dao.HorizontalPodAutoscaler{},
 		Renderer: &render.HorizontalPodAutoscaler{},
+		Sampler:  HPASampler{},
 	},
 	"autoscaling/v2beta2/horizontalpodautoscalers": {
 		DAO:      &dao.HorizontalPodAutoscaler{},
 		Renderer: &render.HorizontalPodAutoscaler{},
+		Sampler:  HPASampler{},
 	},
 
 	// CRDs...
@@ -187,6 +235,7 @@ var Registry = map[string]ResourceMeta{
 
 	// Policy...
 	"policy/v1beta1/poddisruptionbudgets": {
+		DAO:      &dao.PodDisruptionBudget{},
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
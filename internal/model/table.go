@@ -17,7 +17,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const initRefreshRate = 300 * time.Millisecond
+const (
+	initRefreshRate = 300 * time.Millisecond
+
+	// eventBatchDelay represents the time to collect informer events before
+	// updating the table rows.
+	eventBatchDelay = 300 * time.Millisecond
+)
 
 // TableListener represents a table model listener.
 type TableListener interface {
@@ -39,6 +45,8 @@ type Table struct {
 	instance    string
 	mx          sync.RWMutex
 	labelFilter string
+	lastTick    time.Time
+	plugin      *render.Plugin
 }
 
 // NewTable returns a new table model.
@@ -140,7 +148,8 @@ func (t *Table) InNamespace(ns string) bool {
 	return len(t.data.RowEvents) > 0 && t.namespace == ns
 }
 
-// SetRefreshRate sets model refresh duration.
+// SetRefreshRate sets model refresh duration. A zero duration loads the
+// model once.
 func (t *Table) SetRefreshRate(d time.Duration) {
 	t.refreshRate = d
 }
@@ -166,27 +175,134 @@ func (t *Table) Peek() render.TableData {
 func (t *Table) updater(ctx context.Context) {
 	defer log.Debug().Msgf("TABLE-MODEL canceled -- %q", t.gvr)
 
+	w := newTableWatcher(t)
+	defer w.unsubscribe()
 	bf := backoff.NewExponentialBackOff()
 	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
-	rate := initRefreshRate
+	tick := time.NewTimer(initRefreshRate)
+	defer tick.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(rate):
-			rate = t.refreshRate
+		case <-w.kick:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventBatchDelay):
+			}
+			t.applyEvents(ctx, w)
+		case <-tick.C:
 			err := backoff.Retry(func() error {
-				return t.refresh(ctx)
+				return t.tick(ctx, w)
 			}, backoff.WithContext(bf, ctx))
 			if err != nil {
 				log.Error().Err(err).Msgf("Retry failed")
 				t.fireTableLoadFailed(err)
 				return
 			}
+			if t.refreshRate > 0 {
+				tick.Reset(t.refreshRate)
+			}
 		}
 	}
 }
 
+// tick updates the model on each refresh. Informer backed tables only age
+// their rows, apply pending events and refresh their metrics. Other tables are
+// fully reconciled.
+func (t *Table) tick(ctx context.Context, w *tableWatcher) error {
+	if !w.subscribe(ctx) || t.isStale() {
+		w.drain()
+		return t.refresh(ctx)
+	}
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping update...")
+		return nil
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	now := time.Now()
+	t.mx.Lock()
+	t.data.Settle()
+	t.data.AgeBy(now.Sub(t.lastTick))
+	t.lastTick = now
+	t.mx.Unlock()
+	w.apply(ctx)
+	t.refreshMetrics(ctx)
+	t.fireTableChanged(t.Peek())
+
+	return nil
+}
+
+func (t *Table) applyEvents(ctx context.Context, w *tableWatcher) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	if w.apply(ctx) {
+		t.fireTableChanged(t.Peek())
+	}
+}
+
+// isStale checks if the model data was cleared since the last reconcile.
+func (t *Table) isStale() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return len(t.data.Header) == 0
+}
+
+// refreshMetrics patches the rows metrics columns with the latest metrics.
+func (t *Table) refreshMetrics(ctx context.Context) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok || f.Client() == nil || !f.Client().HasMetrics() {
+		return
+	}
+	m := resourceMeta(t.gvr)
+	md, ok := m.DAO.(dao.MetricsDecorator)
+	if !ok {
+		return
+	}
+	if !t.hasMetrics() {
+		return
+	}
+	m.DAO.Init(f, t.gvr)
+	oo, err := md.DecorateMetrics(ctx, t.listNamespace())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Metrics refresh failed for %q", t.gvr)
+		return
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	idx := t.data.RowEvents.IndexByID()
+	for _, o := range oo {
+		var row render.Row
+		if err := m.Renderer.Render(o, t.namespace, &row); err != nil {
+			log.Error().Err(err).Msgf("Render failed for %q", t.gvr)
+			continue
+		}
+		if i, ok := idx[row.ID]; ok {
+			patchMetrics(t.data.Header, t.data.RowEvents[i].Row, row)
+		}
+	}
+}
+
+// hasMetrics checks if the table displays metrics columns.
+func (t *Table) hasMetrics() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	for _, h := range t.data.Header {
+		if h.MX {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (t *Table) refresh(ctx context.Context) error {
 	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
 		log.Debug().Msgf("Dropping update...")
@@ -202,6 +318,20 @@ func (t *Table) refresh(ctx context.Context) error {
 	return nil
 }
 
+func (t *Table) getLabelFilter() string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	return t.labelFilter
+}
+
+func (t *Table) listNamespace() string {
+	if client.IsClusterScoped(t.namespace) {
+		return client.AllNamespaces
+	}
+
+	return client.CleanseNamespace(t.namespace)
+}
+
 func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -209,18 +339,13 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 	}
 	a.Init(factory, t.gvr)
 
-	ns := client.CleanseNamespace(t.namespace)
-	if client.IsClusterScoped(t.namespace) {
-		ns = client.AllNamespaces
-	}
-
-	return a.List(ctx, ns)
+	return a.List(ctx, t.listNamespace())
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
 	t.mx.Lock()
 	defer t.mx.Unlock()
-	meta := resourceMeta(t.gvr)
+	meta := t.resourceMeta()
 	if t.labelFilter != "" {
 		ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
 	}
@@ -237,6 +362,9 @@ func (t *Table) reconcile(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if meta.Sampler != nil {
+		sample(ctx, meta.Sampler, oo)
+	}
 
 	var rows render.Rows
 	if len(oo) > 0 {
@@ -264,6 +392,7 @@ func (t *Table) reconcile(ctx context.Context) error {
 	}
 	t.data.Update(rows)
 	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.lastTick = time.Now()
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -272,6 +401,22 @@ func (t *Table) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// resourceMeta returns the table resource meta. Plugin renderers track their
+// plugin headers hence each table gets its own instance. Callers must hold the
+// table lock.
+func (t *Table) resourceMeta() ResourceMeta {
+	meta := resourceMeta(t.gvr)
+	if _, ok := meta.Renderer.(*render.Plugin); !ok {
+		return meta
+	}
+	if t.plugin == nil {
+		t.plugin = new(render.Plugin)
+	}
+	meta.Renderer = t.plugin
+
+	return meta
+}
+
 func (t *Table) fireTableChanged(data render.TableData) {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -290,6 +435,20 @@ func (t *Table) fireTableLoadFailed(err error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// sample records the listed resources states for the active context.
+func sample(ctx context.Context, s Sampler, oo []runtime.Object) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok || f.Client() == nil {
+		return
+	}
+	n, err := f.Client().Config().CurrentContextName()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Sampling failed")
+		return
+	}
+	s.Sample(n, oo)
+}
+
 func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error {
 	for i, o := range oo {
 		if err := re.Render(o, ns, &rr[i]); err != nil {
@@ -227,3 +227,20 @@ func (a *accessor) Init(_ dao.Factory, gvr client.GVR) {
 func (a *accessor) GVR() string {
 	return a.gvr.String()
 }
+
+func TestTableResourceMetaPlugin(t *testing.T) {
+	t1, t2 := NewTable(client.NewGVR("plugins")), NewTable(client.NewGVR("plugins"))
+
+	r1, r2 := t1.resourceMeta().Renderer, t2.resourceMeta().Renderer
+	assert.NotSame(t, r1, r2)
+	assert.NotSame(t, Registry["plugins"].Renderer, r1)
+	assert.Same(t, r1, t1.resourceMeta().Renderer)
+
+	var row render.Row
+	assert.Nil(t, r1.Render(render.PluginRes{Header: []string{"a", "b"}, Row: render.PluginRow{Fields: []string{"1", "2"}}}, "", &row))
+	assert.Equal(t, 3, len(r1.Header("")))
+	assert.Equal(t, 2, len(r2.Header("")))
+
+	pod := NewTable(client.NewGVR("v1/pods"))
+	assert.Same(t, Registry["v1/pods"].Renderer, pod.resourceMeta().Renderer)
+}
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"context"
+	"sync"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/watch"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// tableWatcher tracks a table model informer events subscription.
+type tableWatcher struct {
+	table          *Table
+	source         dao.EventSource
+	ns, gvr, label string
+	events         map[string]watch.ResourceEvent
+	kick           chan struct{}
+	mx             sync.Mutex
+}
+
+func newTableWatcher(t *Table) *tableWatcher {
+	return &tableWatcher{
+		table:  t,
+		events: make(map[string]watch.ResourceEvent),
+		kick:   make(chan struct{}, 1),
+	}
+}
+
+// ResourceChanged notifies a watched resource changed.
+func (w *tableWatcher) ResourceChanged(evt watch.ResourceEvent) {
+	m, err := meta.Accessor(evt.Object)
+	if err != nil {
+		log.Error().Err(err).Msgf("Watch event for %q", w.gvr)
+		return
+	}
+
+	w.mx.Lock()
+	w.events[eventFQN(m)] = evt
+	w.mx.Unlock()
+
+	select {
+	case w.kick <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe ensures the watcher tracks the table current namespace and labels.
+// Returns true if the watcher was already in sync and false if the table needs
+// to be fully reconciled.
+func (w *tableWatcher) subscribe(ctx context.Context) bool {
+	t := w.table
+	wa, ok := resourceMeta(t.gvr).DAO.(dao.Watchable)
+	if !ok || !wa.CanWatch() || t.instance != "" {
+		w.unsubscribe()
+		return false
+	}
+	src, ok := ctx.Value(internal.KeyFactory).(dao.EventSource)
+	if !ok {
+		return false
+	}
+
+	ns, gvr, label := t.listNamespace(), t.gvr.String(), t.getLabelFilter()
+	if w.source != nil && w.ns == ns && w.gvr == gvr {
+		if w.label == label {
+			return true
+		}
+		w.label = label
+		return false
+	}
+	w.unsubscribe()
+	if err := src.AddListener(ns, gvr, w); err != nil {
+		log.Error().Err(err).Msgf("Watch failed for %q", gvr)
+		return false
+	}
+	w.source, w.ns, w.gvr, w.label = src, ns, gvr, label
+
+	return false
+}
+
+func (w *tableWatcher) unsubscribe() {
+	if w.source == nil {
+		return
+	}
+	w.source.RemoveListener(w.ns, w.gvr, w)
+	w.source = nil
+	w.drain()
+}
+
+// drain returns and clears out pending events.
+func (w *tableWatcher) drain() map[string]watch.ResourceEvent {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	ee := w.events
+	w.events = make(map[string]watch.ResourceEvent)
+
+	return ee
+}
+
+// apply renders rows for all pending events. Returns true if rows changed.
+func (w *tableWatcher) apply(ctx context.Context) bool {
+	ee := w.drain()
+	if len(ee) == 0 {
+		return false
+	}
+
+	t := w.table
+	m := resourceMeta(t.gvr)
+	wa, ok := m.DAO.(dao.Watchable)
+	if !ok {
+		return false
+	}
+	if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok {
+		m.DAO.Init(f, t.gvr)
+	}
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	if label := t.getLabelFilter(); label != "" {
+		ctx = context.WithValue(ctx, internal.KeyLabels, label)
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	idx, deleted := t.data.RowEvents.IndexByID(), make(map[string]struct{})
+	for fqn, evt := range ee {
+		o, err := wa.Decorate(ctx, evt.Object)
+		if err != nil {
+			log.Error().Err(err).Msgf("Decorate failed for %q", fqn)
+			continue
+		}
+		if o == nil {
+			deleted[fqn] = struct{}{}
+			continue
+		}
+		var row render.Row
+		if err := m.Renderer.Render(o, t.namespace, &row); err != nil {
+			log.Error().Err(err).Msgf("Render failed for %q", fqn)
+			continue
+		}
+		if evt.Kind == watch.ResourceDeleted {
+			deleted[row.ID] = struct{}{}
+			continue
+		}
+		i, ok := idx[row.ID]
+		if !ok {
+			idx[row.ID] = len(t.data.RowEvents)
+			t.data.RowEvents = append(t.data.RowEvents, render.NewRowEvent(render.EventAdd, row))
+			continue
+		}
+		carryMetrics(t.data.Header, t.data.RowEvents[i].Row, &row)
+		t.data.UpdateRowAt(i, row)
+	}
+	t.data.DeleteRows(deleted)
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func eventFQN(m metav1.Object) string {
+	return client.MetaFQN(metav1.ObjectMeta{Namespace: m.GetNamespace(), Name: m.GetName()})
+}
+
+// carryMetrics preserves a row metrics columns as metrics are only refreshed
+// on each tick.
+func carryMetrics(h render.Header, old render.Row, row *render.Row) {
+	for i, c := range h {
+		if c.MX && i < len(row.Fields) && i < len(old.Fields) {
+			row.Fields[i] = old.Fields[i]
+		}
+	}
+}
+
+// patchMetrics updates an existing row metrics columns from a given row.
+func patchMetrics(h render.Header, old, row render.Row) {
+	for i, c := range h {
+		if c.MX && i < len(row.Fields) && i < len(old.Fields) {
+			old.Fields[i] = row.Fields[i]
+		}
+	}
+}
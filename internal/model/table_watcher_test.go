@@ -0,0 +1,171 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTableWatcherSubscribe(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace("default")
+	f := newWatchFactory()
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+
+	w := newTableWatcher(ta)
+	assert.False(t, w.subscribe(ctx))
+	assert.True(t, w.subscribe(ctx))
+	assert.Equal(t, 1, len(f.listeners["default:v1/pods"]))
+
+	ta.SetLabelFilter("app=fred")
+	assert.False(t, w.subscribe(ctx))
+	assert.True(t, w.subscribe(ctx))
+
+	ta.SetNamespace("blee")
+	assert.False(t, w.subscribe(ctx))
+	assert.Equal(t, 0, len(f.listeners["default:v1/pods"]))
+	assert.Equal(t, 1, len(f.listeners["blee:v1/pods"]))
+
+	w.unsubscribe()
+	assert.Equal(t, 0, len(f.listeners["blee:v1/pods"]))
+}
+
+func TestTableWatcherApply(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+	f := newWatchFactory()
+	f.rows = []runtime.Object{load(t, "p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	assert.Nil(t, ta.reconcile(ctx))
+
+	w := newTableWatcher(ta)
+	assert.False(t, w.apply(ctx))
+
+	p2 := load(t, "p1")
+	p2.SetName("p2")
+	w.ResourceChanged(watch.ResourceEvent{Kind: watch.ResourceAdded, Object: p2})
+	assert.True(t, w.apply(ctx))
+	data := ta.Peek()
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, render.EventAdd, data.RowEvents[1].Kind)
+
+	w.ResourceChanged(watch.ResourceEvent{Kind: watch.ResourceDeleted, Object: load(t, "p1")})
+	assert.True(t, w.apply(ctx))
+	data = ta.Peek()
+	assert.Equal(t, 1, len(data.RowEvents))
+	assert.Equal(t, "default/p2", data.RowEvents[0].Row.ID)
+
+	ctx = context.WithValue(ctx, internal.KeyLabels, "app=fred")
+	ta.SetLabelFilter("app=fred")
+	w.ResourceChanged(watch.ResourceEvent{Kind: watch.ResourceUpdated, Object: p2})
+	assert.True(t, w.apply(ctx))
+	assert.Equal(t, 0, len(ta.Peek().RowEvents))
+}
+
+func TestCarryMetrics(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "CPU", MX: true},
+		},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "fred", Fields: render.Fields{"fred", "10"}}},
+		},
+	}
+
+	r1 := render.Row{ID: "fred", Fields: render.Fields{"fred", "0"}}
+	carryMetrics(data.Header, data.RowEvents[0].Row, &r1)
+	assert.Equal(t, render.Fields{"fred", "10"}, r1.Fields)
+
+	r2 := render.Row{ID: "blee", Fields: render.Fields{"blee", "0", "extra"}}
+	carryMetrics(data.Header, render.Row{ID: "blee", Fields: render.Fields{"blee"}}, &r2)
+	assert.Equal(t, render.Fields{"blee", "0", "extra"}, r2.Fields)
+}
+
+func TestTableTickMetrics(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+	f := newWatchFactory()
+	f.rows = []runtime.Object{load(t, "p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	assert.Nil(t, ta.reconcile(ctx))
+	assert.True(t, ta.hasMetrics())
+
+	w := newTableWatcher(ta)
+	assert.False(t, w.subscribe(ctx))
+	p2 := load(t, "p1")
+	p2.SetName("p2")
+	f.rows = append(f.rows, p2)
+	assert.Nil(t, ta.tick(ctx, w))
+	data := ta.Peek()
+	assert.Equal(t, 1, len(data.RowEvents))
+	assert.Equal(t, "default/nginx-7fb78fb6d8-2w75j", data.RowEvents[0].Row.ID)
+}
+
+func TestPatchMetrics(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "STATUS"},
+			render.HeaderColumn{Name: "CPU", MX: true},
+		},
+		RowEvents: render.RowEvents{
+			{Kind: render.EventUnchanged, Row: render.Row{ID: "fred", Fields: render.Fields{"fred", "Running", "10"}}},
+		},
+	}
+
+	patchMetrics(data.Header, data.RowEvents[0].Row, render.Row{ID: "fred", Fields: render.Fields{"fred", "Pending", "20"}})
+	assert.Equal(t, render.Fields{"fred", "Running", "20"}, data.RowEvents[0].Row.Fields)
+	assert.Equal(t, render.EventUnchanged, data.RowEvents[0].Kind)
+
+	patchMetrics(data.Header, data.RowEvents[0].Row, render.Row{ID: "fred", Fields: render.Fields{"fred"}})
+	assert.Equal(t, render.Fields{"fred", "Running", "20"}, data.RowEvents[0].Row.Fields)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type watchFactory struct {
+	testFactory
+
+	listeners map[string][]watch.ResourceListener
+}
+
+// Client returns a connection reporting metrics support.
+func (f *watchFactory) Client() client.Connection {
+	return mxConnection{client.NewTestAPIClient()}
+}
+
+func newWatchFactory() *watchFactory {
+	return &watchFactory{listeners: make(map[string][]watch.ResourceListener)}
+}
+
+func (f *watchFactory) AddListener(ns, gvr string, l watch.ResourceListener) error {
+	f.listeners[ns+":"+gvr] = append(f.listeners[ns+":"+gvr], l)
+	return nil
+}
+
+func (f *watchFactory) RemoveListener(ns, gvr string, l watch.ResourceListener) {
+	ll := f.listeners[ns+":"+gvr]
+	for i, lis := range ll {
+		if lis == l {
+			f.listeners[ns+":"+gvr] = append(ll[:i], ll[i+1:]...)
+			return
+		}
+	}
+}
+
+type mxConnection struct {
+	*client.APIClient
+}
+
+func (mxConnection) HasMetrics() bool {
+	return true
+}
@@ -117,9 +117,16 @@ type TreeRenderer interface {
 	Render(ctx context.Context, ns string, o interface{}) error
 }
 
+// Sampler represents a resource which states are recorded on each refresh.
+type Sampler interface {
+	// Sample records the given resources states for a given context.
+	Sample(contextName string, oo []runtime.Object)
+}
+
 // ResourceMeta represents model info about a resource.
 type ResourceMeta struct {
 	DAO          dao.Accessor
 	Renderer     Renderer
 	TreeRenderer TreeRenderer
+	Sampler      Sampler
 }
@@ -0,0 +1,239 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NodeAlloc renders a node allocation breakdown to screen.
+type NodeAlloc struct{}
+
+// ColorerFunc colors a resource row.
+func (NodeAlloc) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (NodeAlloc) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "MAX-PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "%PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU/A", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/A", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "OVERCOMMIT", Align: tview.AlignRight},
+		HeaderColumn{Name: "TAINTS"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (n NodeAlloc) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(NodeAllocRes)
+	if !ok {
+		return fmt.Errorf("Expected NodeAllocRes, but got %T", o)
+	}
+	no := res.Node
+
+	statuses := make(sort.StringSlice, 10)
+	status(no.Status.Conditions, no.Spec.Unschedulable, statuses)
+	sort.Sort(statuses)
+
+	a, l := res.Allocation()
+	maxPods := no.Status.Allocatable.Pods().Value()
+	r.ID = client.FQN("", no.Name)
+	r.Fields = Fields{
+		no.Name,
+		join(statuses, ","),
+		strconv.Itoa(len(res.Pods)),
+		strconv.Itoa(int(maxPods)),
+		client.ToPercentageStr(int64(len(res.Pods)), maxPods),
+		toMc(a.cpu),
+		toMc(l.cpu),
+		toMc(l.lcpu),
+		client.ToPercentageStr(l.cpu, a.cpu),
+		client.ToPercentageStr(l.lcpu, a.cpu),
+		toMi(a.mem),
+		toMi(l.mem),
+		toMi(l.lmem),
+		client.ToPercentageStr(l.mem, a.mem),
+		client.ToPercentageStr(l.lmem, a.mem),
+		overcommit(a, l),
+		ToTaints(no.Spec.Taints),
+		asStatus(n.diagnose(a, l, len(res.Pods), maxPods)),
+		toAge(no.ObjectMeta.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (NodeAlloc) diagnose(a, l metric, pods int, maxPods int64) error {
+	if a.cpu > 0 && l.cpu > a.cpu {
+		return errors.New("cpu requests exceed allocatable")
+	}
+	if a.mem > 0 && l.mem > a.mem {
+		return errors.New("memory requests exceed allocatable")
+	}
+	if maxPods > 0 && int64(pods) >= maxPods {
+		return errors.New("pod capacity reached")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodeAllocRes represents a node and its scheduled pods.
+type NodeAllocRes struct {
+	Node *v1.Node
+	Pods []*v1.Pod
+}
+
+// GetObjectKind returns a schema object.
+func (NodeAllocRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NodeAllocRes) DeepCopyObject() runtime.Object {
+	return n
+}
+
+// Allocation returns the node allocatable and the pods requests and limits.
+func (n NodeAllocRes) Allocation() (a, l metric) {
+	a.cpu, a.mem = n.Node.Status.Allocatable.Cpu().MilliValue(), n.Node.Status.Allocatable.Memory().Value()
+	for _, po := range n.Pods {
+		rcpu, rmem := podRequests(po.Spec)
+		lcpu, lmem := podLimits(po.Spec)
+		l.cpu, l.mem = l.cpu+rcpu.MilliValue(), l.mem+rmem.Value()
+		l.lcpu, l.lmem = l.lcpu+lcpu.MilliValue(), l.lmem+lmem.Value()
+	}
+
+	return
+}
+
+// overcommit returns the highest limits to allocatable ratio.
+func overcommit(a, l metric) string {
+	var ratio float64
+	if a.cpu > 0 {
+		ratio = float64(l.lcpu) / float64(a.cpu)
+	}
+	if a.mem > 0 {
+		if r := float64(l.lmem) / float64(a.mem); r > ratio {
+			ratio = r
+		}
+	}
+
+	return strconv.FormatFloat(ratio, 'f', 2, 64)
+}
+
+// ToTaints returns a node taints as a string.
+func ToTaints(tt []v1.Taint) string {
+	if len(tt) == 0 {
+		return MissingValue
+	}
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		s := t.Key
+		if t.Value != "" {
+			s += "=" + t.Value
+		}
+		ss = append(ss, s+":"+string(t.Effect))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// ----------------------------------------------------------------------------
+
+// PodAlloc renders a pod allocation on a node to screen.
+type PodAlloc struct{}
+
+// ColorerFunc colors a resource row.
+func (PodAlloc) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (PodAlloc) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "QOS"},
+		HeaderColumn{Name: "CPU/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PodAlloc) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(PodAllocRes)
+	if !ok {
+		return fmt.Errorf("Expected PodAllocRes, but got %T", o)
+	}
+	po := res.Pod
+
+	rcpu, rmem := podRequests(po.Spec)
+	lcpu, lmem := podLimits(po.Spec)
+	acpu, amem := res.Allocatable.Cpu().MilliValue(), res.Allocatable.Memory().Value()
+	var p Pod
+	r.ID = client.MetaFQN(po.ObjectMeta)
+	r.Fields = Fields{
+		po.Namespace,
+		po.Name,
+		p.Phase(po),
+		p.mapQOS(po.Status.QOSClass),
+		toMc(rcpu.MilliValue()),
+		toMc(lcpu.MilliValue()),
+		client.ToPercentageStr(rcpu.MilliValue(), acpu),
+		toMi(rmem.Value()),
+		toMi(lmem.Value()),
+		client.ToPercentageStr(rmem.Value(), amem),
+		toAge(po.ObjectMeta.CreationTimestamp),
+	}
+
+	return nil
+}
+
+// PodAllocRes represents a pod and its node allocatable resources.
+type PodAllocRes struct {
+	Pod         *v1.Pod
+	Allocatable v1.ResourceList
+}
+
+// GetObjectKind returns a schema object.
+func (PodAllocRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PodAllocRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,77 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeAllocRender(t *testing.T) {
+	no, po := loadNode(t), loadPod(t)
+	no.Spec.Taints = []v1.Taint{{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}}
+
+	var n render.NodeAlloc
+	r := render.NewRow(19)
+	assert.Nil(t, n.Render(render.NodeAllocRes{Node: no, Pods: []*v1.Pod{po, po}}, "", &r))
+
+	assert.Equal(t, "minikube", r.ID)
+	assert.Equal(t, render.Fields{
+		"minikube", "Ready", "2", "110", "1",
+		"4000", "200", "0", "5", "0",
+		"7874", "140", "340", "1", "4",
+		"0.04", "dedicated=gpu:NoSchedule", "",
+	}, r.Fields[:18])
+}
+
+func TestPodAllocRender(t *testing.T) {
+	no, po := loadNode(t), loadPod(t)
+
+	var p render.PodAlloc
+	r := render.NewRow(11)
+	assert.Nil(t, p.Render(render.PodAllocRes{Pod: po, Allocatable: no.Status.Allocatable}, "", &r))
+
+	assert.Equal(t, "default/nginx", r.ID)
+	assert.Equal(t, render.Fields{"default", "nginx", "Running", "BE", "100", "0", "2", "70", "170", "0"}, r.Fields[:10])
+}
+
+func TestToTaints(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Taint
+		e  string
+	}{
+		"none": {e: render.MissingValue},
+		"many": {
+			tt: []v1.Taint{
+				{Key: "a", Value: "b", Effect: v1.TaintEffectNoExecute},
+				{Key: "c", Effect: v1.TaintEffectPreferNoSchedule},
+			},
+			e: "a=b:NoExecute,c:PreferNoSchedule",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToTaints(u.tt))
+		})
+	}
+}
+
+// Helpers...
+
+func loadNode(t *testing.T) *v1.Node {
+	var no v1.Node
+	assert.Nil(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "no").Object, &no))
+
+	return &no
+}
+
+func loadPod(t *testing.T) *v1.Pod {
+	var po v1.Pod
+	assert.Nil(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "po").Object, &po))
+
+	return &po
+}
@@ -0,0 +1,141 @@
+package render
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Cert renders a TLS secret certificate to screen.
+type Cert struct{}
+
+// ColorerFunc colors a resource row.
+func (Cert) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Cert) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "SECRET"},
+		HeaderColumn{Name: "CHAIN"},
+		HeaderColumn{Name: "SUBJECT"},
+		HeaderColumn{Name: "SANS"},
+		HeaderColumn{Name: "ISSUER"},
+		HeaderColumn{Name: "NOT-AFTER"},
+		HeaderColumn{Name: "DAYS", Align: tview.AlignRight},
+		HeaderColumn{Name: "INGRESSES"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c Cert) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(CertRes)
+	if !ok {
+		return fmt.Errorf("Expected CertRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Secret+":"+strconv.Itoa(res.Index))
+	r.Fields = Fields{
+		res.Namespace,
+		res.Secret,
+		res.chain(),
+		NAValue,
+		NAValue,
+		NAValue,
+		NAValue,
+		NAValue,
+		missing(strings.Join(res.Ingresses, ",")),
+		asStatus(res.diagnose(time.Now())),
+		toAge(res.Created),
+	}
+	if cert := res.Cert; cert != nil {
+		r.Fields[3] = cert.Subject.CommonName
+		r.Fields[4] = missing(strings.Join(certSANs(cert), ","))
+		r.Fields[5] = cert.Issuer.CommonName
+		r.Fields[6] = cert.NotAfter.UTC().Format(time.RFC3339)
+		r.Fields[7] = strconv.Itoa(res.DaysLeft(time.Now()))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CertRes represents a certificate from a TLS secret chain.
+type CertRes struct {
+	Namespace, Secret string
+	Index, ChainLen   int
+	Cert              *x509.Certificate
+	Ingresses         []string
+	Err               error
+	Created           metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (CertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c CertRes) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// DaysLeft returns the number of days before the certificate expires.
+// Expired certificates yield negative values.
+func (c CertRes) DaysLeft(now time.Time) int {
+	if c.Cert == nil {
+		return 0
+	}
+	return int(math.Floor(c.Cert.NotAfter.Sub(now).Hours() / 24))
+}
+
+func (c CertRes) chain() string {
+	if c.ChainLen == 0 {
+		return NAValue
+	}
+	return strconv.Itoa(c.Index+1) + "/" + strconv.Itoa(c.ChainLen)
+}
+
+func (c CertRes) diagnose(now time.Time) error {
+	if c.Err != nil {
+		return c.Err
+	}
+	if c.Cert == nil {
+		return errors.New("no certificate found")
+	}
+	if now.After(c.Cert.NotAfter) {
+		return errors.New("certificate expired")
+	}
+	if now.Before(c.Cert.NotBefore) {
+		return errors.New("certificate not yet valid")
+	}
+
+	return nil
+}
+
+func certSANs(cert *x509.Certificate) []string {
+	ss := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses)+len(cert.EmailAddresses))
+	ss = append(ss, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		ss = append(ss, ip.String())
+	}
+	ss = append(ss, cert.EmailAddresses...)
+
+	return ss
+}
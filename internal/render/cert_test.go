@@ -0,0 +1,92 @@
+package render_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertRender(t *testing.T) {
+	notAfter := time.Now().Add(10*24*time.Hour + time.Hour)
+	uu := map[string]struct {
+		res render.CertRes
+		e   render.Fields
+	}{
+		"valid": {
+			res: render.CertRes{
+				Namespace: "default",
+				Secret:    "fred-tls",
+				ChainLen:  2,
+				Cert:      makeCert(t, notAfter),
+				Ingresses: []string{"fred", "blee"},
+			},
+			e: render.Fields{
+				"default", "fred-tls", "1/2", "fred.io", "fred.io,www.fred.io,10.0.0.1", "fred.io",
+				notAfter.UTC().Format(time.RFC3339), "10", "fred,blee", "",
+			},
+		},
+		"expired": {
+			res: render.CertRes{
+				Namespace: "default",
+				Secret:    "fred-tls",
+				ChainLen:  1,
+				Cert:      makeCert(t, notAfter.Add(-20*24*time.Hour)),
+			},
+			e: render.Fields{
+				"default", "fred-tls", "1/1", "fred.io", "fred.io,www.fred.io,10.0.0.1", "fred.io",
+				notAfter.Add(-20 * 24 * time.Hour).UTC().Format(time.RFC3339), "-10", "<none>", "certificate expired",
+			},
+		},
+		"missing": {
+			res: render.CertRes{
+				Namespace: "default",
+				Secret:    "fred-tls",
+				Ingresses: []string{"fred"},
+				Err:       errors.New("secret not found"),
+			},
+			e: render.Fields{"default", "fred-tls", "n/a", "n/a", "n/a", "n/a", "n/a", "n/a", "fred", "secret not found"},
+		},
+	}
+
+	var c render.Cert
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.res, "", &r))
+			assert.Equal(t, "default/fred-tls:0", r.ID)
+			assert.Equal(t, u.e, r.Fields[:10])
+		})
+	}
+}
+
+// Helpers...
+
+func makeCert(t *testing.T, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred.io"},
+		DNSNames:     []string{"fred.io", "www.fred.io"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+
+	return cert
+}
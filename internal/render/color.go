@@ -1,6 +1,8 @@
 package render
 
 import (
+	"strings"
+
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -50,3 +52,39 @@ func DefaultColorer(ns string, h Header, re RowEvent) tcell.Color {
 		return StdColor
 	}
 }
+
+// RowStatus returns a textual row status so status does not solely rely on
+// colors. The status is derived from the row state rather than its color.
+func RowStatus(ns string, h Header, re RowEvent) string {
+	if !Happy(ns, h, re.Row) {
+		return "ERROR"
+	}
+
+	col := h.IndexOf("STATUS", true)
+	if col == -1 {
+		col = h.IndexOf("STATE", true)
+	}
+	if col >= 0 && col < len(re.Row.Fields) {
+		switch strings.TrimSpace(re.Row.Fields[col]) {
+		case Failed:
+			return "ERROR"
+		case Pending:
+			return "PENDING"
+		case Completed:
+			return "COMPLETED"
+		case Terminating:
+			return "DELETED"
+		}
+	}
+
+	switch re.Kind {
+	case EventAdd:
+		return "ADDED"
+	case EventUpdate:
+		return "MODIFIED"
+	case EventDelete:
+		return "DELETED"
+	default:
+		return "OK"
+	}
+}
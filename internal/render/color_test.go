@@ -61,3 +61,54 @@ func TestDefaultColorer(t *testing.T) {
 		})
 	}
 }
+
+func TestRowStatus(t *testing.T) {
+	uu := map[string]struct {
+		h  render.Header
+		re render.RowEvent
+		e  string
+	}{
+		"ok": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "VALID"}},
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"a", ""}}},
+			e:  "OK",
+		},
+		"added": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "VALID"}},
+			re: render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: render.Fields{"a", ""}}},
+			e:  "ADDED",
+		},
+		"modified": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "VALID"}},
+			re: render.RowEvent{Kind: render.EventUpdate, Row: render.Row{Fields: render.Fields{"a", ""}}},
+			e:  "MODIFIED",
+		},
+		"invalid": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "VALID"}},
+			re: render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: render.Fields{"a", "blee"}}},
+			e:  "ERROR",
+		},
+		"pending": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "STATUS"}},
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"a", render.Pending}}},
+			e:  "PENDING",
+		},
+		"completed": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "STATE"}},
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"a", render.Completed}}},
+			e:  "COMPLETED",
+		},
+		"failed": {
+			h:  render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "STATUS"}},
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"a", render.Failed}}},
+			e:  "ERROR",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.RowStatus("", u.h, u.re))
+		})
+	}
+}
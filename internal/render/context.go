@@ -3,11 +3,14 @@ package render
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
@@ -21,9 +24,21 @@ func (Context) ColorerFunc() ColorerFunc {
 		if r.Kind == EventAdd || r.Kind == EventUpdate {
 			return c
 		}
+		var status string
+		if idx := h.IndexOf("STATUS", true); idx >= 0 && idx < len(r.Row.Fields) {
+			status = r.Row.Fields[idx]
+		}
+		switch status {
+		case "", client.ContextHealthy, client.ContextPending, client.ContextExpiring:
+		default:
+			return ErrColor
+		}
 		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
 			return HighlightColor
 		}
+		if status == client.ContextPending || status == client.ContextExpiring {
+			return PendingColor
+		}
 
 		return c
 	}
@@ -36,6 +51,9 @@ func (Context) Header(ns string) Header {
 		HeaderColumn{Name: "CLUSTER"},
 		HeaderColumn{Name: "AUTHINFO"},
 		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "EXPIRES", Wide: true},
 	}
 }
 
@@ -57,11 +75,32 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		client.ContextPending,
+		NAValue,
+		NAValue,
+	}
+	if h := ctx.Health; h != nil {
+		r.Fields[4] = h.Status(time.Now())
+		if h.Version != "" {
+			r.Fields[5] = h.Version
+		}
+		if !h.Expiry.IsZero() {
+			r.Fields[6] = toExpiry(h.Expiry)
+		}
 	}
 
 	return nil
 }
 
+func toExpiry(t time.Time) string {
+	d := time.Until(t)
+	if d < 0 {
+		return "-" + duration.HumanDuration(-d)
+	}
+
+	return duration.HumanDuration(d)
+}
+
 // Helpers...
 
 // NamedContext represents a named cluster context.
@@ -69,6 +108,7 @@ type NamedContext struct {
 	Name    string
 	Context *api.Context
 	Config  ContextNamer
+	Health  *client.ContextHealth
 }
 
 // ContextNamer represents a named context.
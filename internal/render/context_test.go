@@ -3,6 +3,7 @@ package render_test
 import (
 	"testing"
 
+	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -11,7 +12,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Equal(t, 4, len(c.Header("")))
+	assert.Equal(t, 7, len(c.Header("")))
 }
 
 func TestContextRender(t *testing.T) {
@@ -32,7 +33,42 @@ func TestContextRender(t *testing.T) {
 			},
 			e: render.Row{
 				ID:     "c1",
-				Fields: render.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: render.Fields{"c1", "c1", "u1", "ns1", "PENDING", "n/a", "n/a"},
+			},
+		},
+		"healthy": {
+			ctx: &render.NamedContext{
+				Name: "c1",
+				Context: &api.Context{
+					Cluster:   "c1",
+					AuthInfo:  "u1",
+					Namespace: "ns1",
+				},
+				Config: &config{},
+				Health: &client.ContextHealth{
+					Reachable: true,
+					AuthValid: true,
+					Version:   "v1.18.8",
+				},
+			},
+			e: render.Row{
+				ID:     "c1",
+				Fields: render.Fields{"c1", "c1", "u1", "ns1", "OK", "v1.18.8", "n/a"},
+			},
+		},
+		"unreachable": {
+			ctx: &render.NamedContext{
+				Name: "c1",
+				Context: &api.Context{
+					Cluster:  "c1",
+					AuthInfo: "u1",
+				},
+				Config: &config{},
+				Health: &client.ContextHealth{},
+			},
+			e: render.Row{
+				ID:     "c1",
+				Fields: render.Fields{"c1", "c1", "u1", "", "UNREACHABLE", "n/a", "n/a"},
 			},
 		},
 	}
@@ -41,7 +77,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := render.NewRow(4)
+			row := render.NewRow(7)
 			err := r.Render(uc.ctx, "", &row)
 
 			assert.Nil(t, err)
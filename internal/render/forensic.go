@@ -0,0 +1,191 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ForensicContainer tracks a container restart record.
+	ForensicContainer = "Container"
+
+	// ForensicEvent tracks a pod event record.
+	ForensicEvent = "Event"
+)
+
+var fieldPathRx = regexp.MustCompile(`\Aspec\.(?:initContainers|containers)\{(.+)\}\z`)
+
+// Forensic renders a pod restarts forensics to screen.
+type Forensic struct{}
+
+// ColorerFunc colors a resource row.
+func (Forensic) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		oomCol := h.IndexOf("OOMKILLED", true)
+		if oomCol >= 0 && strings.TrimSpace(re.Row.Fields[oomCol]) == "true" {
+			return ErrColor
+		}
+		typeCol := h.IndexOf("TYPE", true)
+		if typeCol >= 0 && strings.TrimSpace(re.Row.Fields[typeCol]) == ForensicEvent {
+			return StdColor
+		}
+
+		return DefaultColorer(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (Forensic) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "CONTAINER"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "EXIT-CODE", Align: tview.AlignRight},
+		HeaderColumn{Name: "SIGNAL", Align: tview.AlignRight},
+		HeaderColumn{Name: "OOMKILLED"},
+		HeaderColumn{Name: "STARTED", Wide: true},
+		HeaderColumn{Name: "FINISHED"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (f Forensic) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ForensicRes)
+	if !ok {
+		return fmt.Errorf("Expected ForensicRes, but got %T", o)
+	}
+
+	r.ID = res.ID
+	r.Fields = Fields{
+		res.Kind,
+		res.Container,
+		res.State,
+		res.Count,
+		res.Reason,
+		res.ExitCode,
+		res.Signal,
+		res.OOMKilled,
+		toTimestamp(res.Started),
+		toTimestamp(res.Finished),
+		res.Message,
+		asStatus(nil),
+		toAge(res.When),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ForensicRes represents a pod container restart or event record.
+// Count tracks container restarts or event occurrences.
+type ForensicRes struct {
+	ID                string
+	Kind              string
+	Container         string
+	State             string
+	Count             string
+	Reason            string
+	ExitCode, Signal  string
+	OOMKilled         string
+	Message           string
+	Started, Finished metav1.Time
+	When              metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (ForensicRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f ForensicRes) DeepCopyObject() runtime.Object {
+	return f
+}
+
+// NewContainerForensic returns a container restart record based on its last termination.
+func NewContainerForensic(cs v1.ContainerStatus, init bool) ForensicRes {
+	f := ForensicRes{
+		ID:        cs.Name,
+		Kind:      ForensicContainer,
+		Container: cs.Name,
+		State:     ToContainerState(cs.State),
+		Count:     strconv.Itoa(int(cs.RestartCount)),
+		Reason:    MissingValue,
+		ExitCode:  NAValue,
+		Signal:    NAValue,
+		OOMKilled: "false",
+	}
+	if init {
+		f.Kind += "(init)"
+	}
+
+	// A terminated container carries its own exit, otherwise fallback to the previous instance.
+	t := cs.State.Terminated
+	if t == nil {
+		t = cs.LastTerminationState.Terminated
+	}
+	if t == nil {
+		if r := cs.State.Running; r != nil {
+			f.Started, f.When = r.StartedAt, r.StartedAt
+		}
+		return f
+	}
+	f.Reason = missing(t.Reason)
+	f.ExitCode, f.Signal = strconv.Itoa(int(t.ExitCode)), strconv.Itoa(int(t.Signal))
+	f.OOMKilled = boolToStr(t.Reason == "OOMKilled")
+	f.Message = t.Message
+	f.Started, f.Finished, f.When = t.StartedAt, t.FinishedAt, t.FinishedAt
+
+	return f
+}
+
+// NewEventForensic returns a pod event record.
+func NewEventForensic(ev v1.Event) ForensicRes {
+	co := MissingValue
+	if m := fieldPathRx.FindStringSubmatch(ev.InvolvedObject.FieldPath); len(m) == 2 {
+		co = m[1]
+	}
+	when := ev.LastTimestamp
+	if when.IsZero() {
+		when = metav1.NewTime(ev.EventTime.Time)
+	}
+
+	return ForensicRes{
+		ID:        client.MetaFQN(ev.ObjectMeta),
+		Kind:      ForensicEvent,
+		Container: co,
+		State:     ev.Type,
+		Count:     strconv.Itoa(int(ev.Count)),
+		Reason:    ev.Reason,
+		ExitCode:  NAValue,
+		Signal:    NAValue,
+		OOMKilled: boolToStr(ev.Reason == "OOMKilling"),
+		Message:   ev.Message,
+		Finished:  when,
+		When:      when,
+	}
+}
+
+func toTimestamp(t metav1.Time) string {
+	if t.IsZero() {
+		return MissingValue
+	}
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
@@ -0,0 +1,85 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewContainerForensic(t *testing.T) {
+	uu := map[string]struct {
+		cs   v1.ContainerStatus
+		init bool
+		e    render.Fields
+	}{
+		"oomkilled": {
+			cs: v1.ContainerStatus{
+				Name:         "fred",
+				RestartCount: 3,
+				State: v1.ContainerState{
+					Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+				},
+				LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137, Signal: 9},
+				},
+			},
+			e: render.Fields{"Container", "fred", "CrashLoopBackOff", "3", "OOMKilled", "137", "9", "true"},
+		},
+		"init": {
+			cs: v1.ContainerStatus{
+				Name:  "blee",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Completed"}},
+			},
+			init: true,
+			e:    render.Fields{"Container(init)", "blee", "Completed", "0", "Completed", "0", "0", "false"},
+		},
+		"healthy": {
+			cs: v1.ContainerStatus{
+				Name:  "zorg",
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			},
+			e: render.Fields{"Container", "zorg", "Running", "0", "<none>", "n/a", "n/a", "false"},
+		},
+	}
+
+	var f render.Forensic
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, f.Render(render.NewContainerForensic(u.cs, u.init), "", &r))
+			assert.Equal(t, u.cs.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields[:8])
+		})
+	}
+}
+
+func TestNewEventForensic(t *testing.T) {
+	ev := v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx.1"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "nginx",
+			FieldPath: "spec.containers{nginx}",
+		},
+		Reason:        "BackOff",
+		Type:          "Warning",
+		Count:         12,
+		Message:       "Back-off restarting failed container",
+		LastTimestamp: makeAge(),
+	}
+
+	var (
+		f render.Forensic
+		r render.Row
+	)
+	assert.Nil(t, f.Render(render.NewEventForensic(ev), "", &r))
+	assert.Equal(t, "default/nginx.1", r.ID)
+	assert.Equal(t, render.Fields{
+		"Event", "nginx", "Warning", "12", "BackOff", "n/a", "n/a", "false",
+		"<none>", "2018-12-14T17:36:43Z", "Back-off restarting failed container",
+	}, r.Fields[:11])
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
@@ -20,7 +21,24 @@ type Job struct{}
 
 // ColorerFunc colors a resource row.
 func (Job) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 || statusCol >= len(re.Row.Fields) {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[statusCol]) {
+		case Failed:
+			return ErrColor
+		case Completed:
+			return CompletedColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -30,6 +48,8 @@ func (Job) Header(ns string) Header {
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "COMPLETIONS"},
 		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "COMPLETED"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
 		HeaderColumn{Name: "IMAGES", Wide: true},
@@ -50,6 +70,13 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 		return err
 	}
 	ready := toCompletion(job.Spec, job.Status)
+	status, completed := Running, MissingValue
+	if at, ok := JobFinishedAt(&job); ok {
+		status, completed = Completed, toAgeHuman(time.Since(at).String())
+		if job.Status.CompletionTime == nil {
+			status = Failed
+		}
+	}
 
 	cc, ii := toContainers(job.Spec.Template.Spec)
 
@@ -59,6 +86,8 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 		job.Name,
 		ready,
 		toDuration(job.Status),
+		status,
+		completed,
 		jobSelector(job.Spec),
 		cc,
 		ii,
@@ -80,6 +109,20 @@ func (Job) diagnose(ready string, completed *metav1.Time) error {
 	return nil
 }
 
+// JobFinishedAt returns the time a job completed or failed.
+func JobFinishedAt(job *batchv1.Job) (time.Time, bool) {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime.Time, true
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == v1.ConditionTrue {
+			return c.LastTransitionTime.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
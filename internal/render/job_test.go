@@ -3,8 +3,12 @@ package render_test
 import (
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestJobRender(t *testing.T) {
@@ -13,5 +17,71 @@ func TestJobRender(t *testing.T) {
 	c.Render(load(t, "job"), "", &r)
 
 	assert.Equal(t, "default/hello-1567179180", r.ID)
-	assert.Equal(t, render.Fields{"default", "hello-1567179180", "1/1", "8s", "controller-uid=7473e6d0-cb3b-11e9-990f-42010a800218", "c1", "blang/busybox-bash"}, r.Fields[:7])
+	assert.Equal(t, render.Fields{"default", "hello-1567179180", "1/1", "8s", "Completed"}, r.Fields[:5])
+	assert.Equal(t, render.Fields{"controller-uid=7473e6d0-cb3b-11e9-990f-42010a800218", "c1", "blang/busybox-bash"}, r.Fields[6:9])
+}
+
+func TestJobFinishedAt(t *testing.T) {
+	now := metav1.Now()
+	uu := map[string]struct {
+		status batchv1.JobStatus
+		ok     bool
+	}{
+		"running": {},
+		"completed": {
+			status: batchv1.JobStatus{CompletionTime: &now},
+			ok:     true,
+		},
+		"failed": {
+			status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: v1.ConditionTrue, LastTransitionTime: now},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			at, ok := render.JobFinishedAt(&batchv1.Job{Status: u.status})
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.True(t, now.Time.Equal(at))
+			}
+		})
+	}
+}
+
+func TestJobColorer(t *testing.T) {
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "STATUS"},
+	}
+	uu := map[string]struct {
+		re render.RowEvent
+		e  tcell.Color
+	}{
+		"failed": {
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"fred", render.Failed}}},
+			e:  render.ErrColor,
+		},
+		"completed": {
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"fred", render.Completed}}},
+			e:  render.CompletedColor,
+		},
+		"running": {
+			re: render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: render.Fields{"fred", render.Running}}},
+			e:  render.StdColor,
+		},
+	}
+
+	f := render.Job{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f("", h, u.re))
+		})
+	}
 }
@@ -0,0 +1,124 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// NetEgress tracks the source pod egress side.
+	NetEgress = "Egress"
+
+	// NetIngress tracks the destination pod ingress side.
+	NetIngress = "Ingress"
+
+	netAllowed = "ALLOWED"
+	netDenied  = "DENIED"
+)
+
+// NetCheck renders a network policies reachability check to screen.
+type NetCheck struct{}
+
+// ColorerFunc colors a resource row.
+func (NetCheck) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("VERDICT", true)
+		if col < 0 {
+			return DefaultColorer(ns, h, re)
+		}
+		if strings.TrimSpace(re.Row.Fields[col]) == netDenied {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (NetCheck) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "DIRECTION"},
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "DESTINATION"},
+		HeaderColumn{Name: "PORT"},
+		HeaderColumn{Name: "VERDICT"},
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "RULE"},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NetCheck) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(NetCheckRes)
+	if !ok {
+		return fmt.Errorf("Expected NetCheckRes, but got %T", o)
+	}
+
+	dst := client.MetaFQN(res.Destination.ObjectMeta)
+	r.ID = res.Direction + ":" + dst
+	r.Fields = Fields{
+		res.Direction,
+		client.MetaFQN(res.Source.ObjectMeta),
+		dst,
+		portStr(res.Port),
+		verdictStr(res.Verdict.Allowed),
+		res.Verdict.Policy,
+		res.Verdict.Rule,
+		res.Verdict.Reason,
+		asStatus(nil),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NetVerdict represents a network policies evaluation outcome.
+type NetVerdict struct {
+	Allowed bool
+	Policy  string
+	Rule    string
+	Reason  string
+}
+
+// NetCheckRes represents a reachability check for one side of a connection.
+type NetCheckRes struct {
+	Direction   string
+	Source      *v1.Pod
+	Destination *v1.Pod
+	Port        string
+	Verdict     NetVerdict
+}
+
+// GetObjectKind returns a schema object.
+func (NetCheckRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NetCheckRes) DeepCopyObject() runtime.Object {
+	return n
+}
+
+func portStr(p string) string {
+	if p == "" {
+		return "*"
+	}
+	return p
+}
+
+func verdictStr(allowed bool) string {
+	if allowed {
+		return netAllowed
+	}
+	return netDenied
+}
@@ -0,0 +1,51 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetCheckRender(t *testing.T) {
+	src := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fe"}}
+	dst := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "data", Name: "db"}}
+
+	uu := map[string]struct {
+		res render.NetCheckRes
+		e   render.Fields
+	}{
+		"allowed": {
+			res: render.NetCheckRes{
+				Direction:   render.NetIngress,
+				Source:      &src,
+				Destination: &dst,
+				Port:        "5432",
+				Verdict:     render.NetVerdict{Allowed: true, Policy: "data/allow", Rule: "ingress[0]", Reason: "rule matches"},
+			},
+			e: render.Fields{"Ingress", "default/fe", "data/db", "5432", "ALLOWED", "data/allow", "ingress[0]", "rule matches", ""},
+		},
+		"denied": {
+			res: render.NetCheckRes{
+				Direction:   render.NetEgress,
+				Source:      &src,
+				Destination: &dst,
+				Verdict:     render.NetVerdict{Policy: "default/deny", Rule: "<none>", Reason: "no rule matches"},
+			},
+			e: render.Fields{"Egress", "default/fe", "data/db", "*", "DENIED", "default/deny", "<none>", "no rule matches", ""},
+		},
+	}
+
+	var n render.NetCheck
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, n.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Direction+":data/db", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
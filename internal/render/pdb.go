@@ -3,12 +3,15 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/open-infra/osc/internal/client"
 	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
 	v1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -17,7 +20,18 @@ type PodDisruptionBudget struct{}
 
 // ColorerFunc colors a resource row.
 func (p PodDisruptionBudget) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		if c == ErrColor {
+			return c
+		}
+		blockCol := h.IndexOf("BLOCKING", true)
+		if blockCol >= 0 && strings.TrimSpace(re.Row.Fields[blockCol]) == "true" {
+			return PendingColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -31,6 +45,9 @@ func (PodDisruptionBudget) Header(ns string) Header {
 		HeaderColumn{Name: "CURRENT", Align: tview.AlignRight},
 		HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
 		HeaderColumn{Name: "EXPECTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "NODES"},
+		HeaderColumn{Name: "BLOCKING"},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
@@ -39,9 +56,14 @@ func (PodDisruptionBudget) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
+	var pods, nodes []string
 	raw, ok := o.(*unstructured.Unstructured)
 	if !ok {
-		return fmt.Errorf("Expected PodDisruptionBudget, but got %T", o)
+		pwp, ok := o.(*PDBWithPods)
+		if !ok {
+			return fmt.Errorf("Expected PodDisruptionBudget, but got %T", o)
+		}
+		raw, pods, nodes = pwp.Raw, pwp.Pods, pwp.Nodes
 	}
 	var pdb v1beta1.PodDisruptionBudget
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pdb)
@@ -59,6 +81,9 @@ func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(pdb.Status.CurrentHealthy)),
 		strconv.Itoa(int(pdb.Status.DesiredHealthy)),
 		strconv.Itoa(int(pdb.Status.ExpectedPods)),
+		strconv.Itoa(len(pods)),
+		strings.Join(nodes, ","),
+		boolToStr(pdb.Status.DisruptionsAllowed == 0),
 		mapToStr(pdb.Labels),
 		asStatus(p.diagnose(pdb.Spec.MinAvailable, pdb.Status.CurrentHealthy)),
 		toAge(pdb.ObjectMeta.CreationTimestamp),
@@ -77,6 +102,25 @@ func (PodDisruptionBudget) diagnose(min *intstr.IntOrString, healthy int32) erro
 	return nil
 }
 
+// PDBWithPods represents a pod disruption budget along with the pods it
+// guards and the nodes hosting them.
+type PDBWithPods struct {
+	Raw   *unstructured.Unstructured
+	Pods  []string
+	Nodes []string
+}
+
+// GetObjectKind returns a schema object.
+func (p *PDBWithPods) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PDBWithPods) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// ----------------------------------------------------------------------------
 // Helpers...
 
 func numbToStr(n *intstr.IntOrString) string {
@@ -3,6 +3,7 @@ package render_test
 import (
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,3 +16,44 @@ func TestPodDisruptionBudgetRender(t *testing.T) {
 	assert.Equal(t, "default/fred", r.ID)
 	assert.Equal(t, render.Fields{"default", "fred", "2", render.NAValue, "0", "0", "2", "0"}, r.Fields[:8])
 }
+
+func TestPodDisruptionBudgetRenderWithPods(t *testing.T) {
+	c := render.PodDisruptionBudget{}
+	r := render.NewRow(14)
+	o := render.PDBWithPods{
+		Raw:   load(t, "pdb"),
+		Pods:  []string{"nginx-1", "nginx-2"},
+		Nodes: []string{"n1", "n2"},
+	}
+	assert.Nil(t, c.Render(&o, "", &r))
+
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"2", "n1,n2", "true"}, r.Fields[8:11])
+}
+
+func TestPodDisruptionBudgetColorer(t *testing.T) {
+	render.ErrColor, render.PendingColor, render.StdColor = tcell.ColorRed, tcell.ColorOrange, tcell.ColorWhite
+	defer func() {
+		render.ErrColor, render.PendingColor, render.StdColor = tcell.ColorDefault, tcell.ColorDefault, tcell.ColorDefault
+	}()
+
+	var p render.PodDisruptionBudget
+	h := p.Header("")
+	uu := map[string]struct {
+		blocking string
+		e        tcell.Color
+	}{
+		"blocking": {blocking: "true", e: tcell.ColorOrange},
+		"allowed":  {blocking: "false", e: tcell.ColorWhite},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := make(render.Fields, len(h))
+			f[h.IndexOf("BLOCKING", true)] = u.blocking
+			re := render.RowEvent{Kind: render.EventUnchanged, Row: render.Row{Fields: f}}
+			assert.Equal(t, u.e, p.ColorerFunc()("", h, re))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Plugin renders a plugin table output to screen.
+type Plugin struct {
+	header []string
+}
+
+// ColorerFunc colors a resource row.
+func (Plugin) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (p *Plugin) Header(_ string) Header {
+	if len(p.header) == 0 {
+		return Header{HeaderColumn{Name: "NAME"}, HeaderColumn{Name: "COMMAND", Wide: true}}
+	}
+	h := make(Header, 0, len(p.header)+1)
+	for _, c := range p.header {
+		h = append(h, HeaderColumn{Name: strings.ToUpper(c)})
+	}
+
+	return append(h, HeaderColumn{Name: "COMMAND", Wide: true})
+}
+
+// Render renders a K8s resource to screen.
+func (p *Plugin) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(PluginRes)
+	if !ok {
+		return fmt.Errorf("Expected PluginRes, but got %T", o)
+	}
+	p.header = res.Header
+
+	r.ID = res.Row.ID
+	if r.ID == "" {
+		r.ID = strconv.Itoa(res.Index)
+	}
+	r.Fields = make(Fields, len(res.Header)+1)
+	for i := range res.Header {
+		if i < len(res.Row.Fields) {
+			r.Fields[i] = res.Row.Fields[i]
+		}
+	}
+	r.Fields[len(res.Header)] = res.Row.Command
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PluginRow represents a plugin output row. Command optionally specifies a
+// view command to navigate to when the row is selected.
+type PluginRow struct {
+	ID      string   `json:"id"`
+	Fields  []string `json:"fields"`
+	Command string   `json:"command"`
+}
+
+// PluginRes represents a plugin table row. Index tracks the row position in
+// the plugin output and identifies rows without an ID.
+type PluginRes struct {
+	Header []string
+	Row    PluginRow
+	Index  int
+}
+
+// GetObjectKind returns a schema object.
+func (PluginRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PluginRes) DeepCopyObject() runtime.Object {
+	return p
+}
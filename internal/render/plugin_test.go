@@ -0,0 +1,73 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.PluginRes
+		id  string
+		e   render.Fields
+	}{
+		"full": {
+			res: render.PluginRes{
+				Header: []string{"name", "status"},
+				Row:    render.PluginRow{ID: "default/fred", Fields: []string{"fred", "ok"}, Command: "pods default"},
+			},
+			id: "default/fred",
+			e:  render.Fields{"fred", "ok", "pods default"},
+		},
+		"short": {
+			res: render.PluginRes{
+				Header: []string{"name", "status", "age"},
+				Row:    render.PluginRow{Fields: []string{"blee"}},
+				Index:  1,
+			},
+			id: "1",
+			e:  render.Fields{"blee", "", "", ""},
+		},
+		"same-name": {
+			res: render.PluginRes{
+				Header: []string{"name"},
+				Row:    render.PluginRow{Fields: []string{"blee"}},
+				Index:  3,
+			},
+			id: "3",
+			e:  render.Fields{"blee", ""},
+		},
+		"long": {
+			res: render.PluginRes{
+				Header: []string{"name"},
+				Row:    render.PluginRow{Fields: []string{"zorg", "extra"}},
+				Index:  2,
+			},
+			id: "2",
+			e:  render.Fields{"zorg", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				p render.Plugin
+				r render.Row
+			)
+			assert.Nil(t, p.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, len(u.res.Header)+1, len(p.Header("")))
+			assert.Equal(t, "COMMAND", p.Header("")[len(u.res.Header)].Name)
+		})
+	}
+}
+
+func TestPluginHeaderBlank(t *testing.T) {
+	var p render.Plugin
+
+	assert.Equal(t, "NAME", p.Header("")[0].Name)
+}
@@ -0,0 +1,323 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// IssueNew tags an issue introduced since a previous run.
+	IssueNew = "NEW"
+	// IssueResolved tags an issue fixed since a previous run.
+	IssueResolved = "RESOLVED"
+
+	rootGroup = "__root__"
+)
+
+// PopeyeHistory renders persisted sanitizer runs to screen.
+type PopeyeHistory struct{}
+
+// ColorerFunc colors a resource row.
+func (PopeyeHistory) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+
+		idx := h.IndexOf("DELTA", true)
+		if idx < 0 {
+			return c
+		}
+		switch delta := strings.TrimSpace(re.Row.Fields[idx]); {
+		case strings.HasPrefix(delta, "-"):
+			return ErrColor
+		case strings.HasPrefix(delta, "+"):
+			return tcell.ColorPaleGreen
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (PopeyeHistory) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SCOPE"},
+		HeaderColumn{Name: "SCORE%", Align: tview.AlignRight},
+		HeaderColumn{Name: "GRADE"},
+		HeaderColumn{Name: "DELTA", Align: tview.AlignRight},
+		HeaderColumn{Name: "NEW", Align: tview.AlignRight},
+		HeaderColumn{Name: "RESOLVED", Align: tview.AlignRight},
+		HeaderColumn{Name: "ERROR", Align: tview.AlignRight},
+		HeaderColumn{Name: "WARNING", Align: tview.AlignRight},
+		HeaderColumn{Name: "INFO", Align: tview.AlignRight},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PopeyeHistory) Render(o interface{}, ns string, r *Row) error {
+	info, ok := o.(PopeyeRunInfo)
+	if !ok {
+		return fmt.Errorf("expected PopeyeRunInfo, but got %T", o)
+	}
+
+	run := info.Run
+	scope := run.Namespace
+	if scope == "" {
+		scope = client.NamespaceAll
+	}
+	delta, added, resolved := NAValue, NAValue, NAValue
+	if info.Previous != nil {
+		delta = fmt.Sprintf("%+d", run.Score-info.Previous.Score)
+		if run.Score == info.Previous.Score {
+			delta = "0"
+		}
+		dd := DiffPopeyeRuns(info.Previous, run)
+		added, resolved = strconv.Itoa(dd.Count(IssueNew)), strconv.Itoa(dd.Count(IssueResolved))
+	}
+	t := run.Tally()
+
+	r.ID = info.Path
+	r.Fields = Fields{
+		run.ID(),
+		scope,
+		strconv.Itoa(run.Score),
+		run.Grade,
+		delta,
+		added,
+		resolved,
+		strconv.Itoa(t.Error),
+		strconv.Itoa(t.Warning),
+		strconv.Itoa(t.Info),
+		timeToAge(run.Timestamp),
+	}
+
+	return nil
+}
+
+// PopeyeDiff renders issue changes between two sanitizer runs to screen.
+type PopeyeDiff struct{}
+
+// ColorerFunc colors a resource row.
+func (PopeyeDiff) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+
+		idx := h.IndexOf("CHANGE", true)
+		if idx < 0 {
+			return c
+		}
+		if re.Row.Fields[idx] == IssueResolved {
+			return tcell.ColorPaleGreen
+		}
+		if re.Row.Fields[h.IndexOf("LEVEL", true)] == levelName(config.ErrorLevel) {
+			return ErrColor
+		}
+
+		return tcell.ColorOrange
+	}
+}
+
+// Header returns a header row.
+func (PopeyeDiff) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SECTION"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "GROUP"},
+		HeaderColumn{Name: "CHANGE"},
+		HeaderColumn{Name: "LEVEL"},
+		HeaderColumn{Name: "MESSAGE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PopeyeDiff) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(IssueDiff)
+	if !ok {
+		return fmt.Errorf("expected IssueDiff, but got %T", o)
+	}
+
+	group := d.Group
+	if group == rootGroup {
+		group = NAValue
+	}
+	r.ID = d.key()
+	r.Fields = Fields{
+		d.Section,
+		d.Resource,
+		group,
+		d.Change,
+		levelName(d.Level),
+		d.Message,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type (
+	// PopeyeRun represents a persisted sanitizer run.
+	PopeyeRun struct {
+		Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+		Cluster   string    `json:"cluster" yaml:"cluster"`
+		Namespace string    `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+		Score     int       `json:"score" yaml:"score"`
+		Grade     string    `json:"grade" yaml:"grade"`
+		Sections  Sections  `json:"sanitizers,omitempty" yaml:"sanitizers,omitempty"`
+	}
+
+	// PopeyeRunInfo represents a run along with the run preceding it.
+	PopeyeRunInfo struct {
+		Path     string
+		Run      *PopeyeRun
+		Previous *PopeyeRun
+	}
+
+	// IssueDiff represents an issue that changed between two runs.
+	IssueDiff struct {
+		Section  string
+		Resource string
+		Group    string
+		Level    config.Level
+		Message  string
+		Change   string
+	}
+
+	// IssueDiffs represents a collection of issue changes.
+	IssueDiffs []IssueDiff
+)
+
+// ID returns the run identifier.
+func (r *PopeyeRun) ID() string {
+	return r.Timestamp.UTC().Format("20060102-150405")
+}
+
+// Tally sums up issues counts across all sections.
+func (r *PopeyeRun) Tally() Tally {
+	var t Tally
+	for _, s := range r.Sections {
+		if s.Tally == nil {
+			continue
+		}
+		t.OK += s.Tally.OK
+		t.Info += s.Tally.Info
+		t.Warning += s.Tally.Warning
+		t.Error += s.Tally.Error
+		t.Count += s.Tally.Count
+	}
+
+	return t
+}
+
+// GetObjectKind returns a schema object.
+func (PopeyeRunInfo) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PopeyeRunInfo) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// GetObjectKind returns a schema object.
+func (IssueDiff) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d IssueDiff) DeepCopyObject() runtime.Object {
+	return d
+}
+
+func (d IssueDiff) key() string {
+	return strings.Join([]string{d.Change, d.Section, d.Resource, d.Group, strconv.Itoa(int(d.Level)), d.Message}, "|")
+}
+
+// Count returns the number of changes of a given kind.
+func (dd IssueDiffs) Count(change string) int {
+	var count int
+	for _, d := range dd {
+		if d.Change == change {
+			count++
+		}
+	}
+
+	return count
+}
+
+// DiffPopeyeRuns lists the issues introduced and resolved between two runs.
+// Issues at ok level are ignored.
+func DiffPopeyeRuns(from, to *PopeyeRun) IssueDiffs {
+	before, after := runIssues(from), runIssues(to)
+
+	dd := make(IssueDiffs, 0)
+	for k, d := range after {
+		if _, ok := before[k]; !ok {
+			d.Change = IssueNew
+			dd = append(dd, d)
+		}
+	}
+	for k, d := range before {
+		if _, ok := after[k]; !ok {
+			d.Change = IssueResolved
+			dd = append(dd, d)
+		}
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].key() < dd[j].key()
+	})
+
+	return dd
+}
+
+func runIssues(r *PopeyeRun) map[string]IssueDiff {
+	mm := make(map[string]IssueDiff)
+	if r == nil {
+		return mm
+	}
+	for _, s := range r.Sections {
+		for res, issues := range s.Outcome {
+			for _, i := range issues {
+				if i.Level <= config.OkLevel {
+					continue
+				}
+				d := IssueDiff{
+					Section:  s.Title,
+					Resource: res,
+					Group:    i.Group,
+					Level:    i.Level,
+					Message:  i.Message,
+				}
+				mm[d.key()] = d
+			}
+		}
+	}
+
+	return mm
+}
+
+func levelName(l config.Level) string {
+	switch l {
+	case config.ErrorLevel:
+		return "ERROR"
+	case config.WarnLevel:
+		return "WARNING"
+	case config.InfoLevel:
+		return "INFO"
+	default:
+		return "OK"
+	}
+}
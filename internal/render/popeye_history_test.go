@@ -0,0 +1,102 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	pcfg "github.com/derailed/popeye/pkg/config"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPopeyeRuns(t *testing.T) {
+	from := makePopeyeRun(80, render.Issues{
+		{Group: "__root__", Level: pcfg.WarnLevel, Message: "no probes"},
+		{Group: "nginx", Level: pcfg.ErrorLevel, Message: "no limits"},
+	})
+	to := makePopeyeRun(90, render.Issues{
+		{Group: "__root__", Level: pcfg.WarnLevel, Message: "no probes"},
+		{Group: "nginx", Level: pcfg.InfoLevel, Message: "latest tag"},
+		{Group: "nginx", Level: pcfg.OkLevel, Message: "all good"},
+	})
+
+	dd := render.DiffPopeyeRuns(from, to)
+	assert.Equal(t, 2, len(dd))
+	assert.Equal(t, 1, dd.Count(render.IssueNew))
+	assert.Equal(t, 1, dd.Count(render.IssueResolved))
+	assert.Equal(t, render.IssueDiff{
+		Section:  "pod",
+		Resource: "default/fred",
+		Group:    "nginx",
+		Level:    pcfg.InfoLevel,
+		Message:  "latest tag",
+		Change:   render.IssueNew,
+	}, dd[0])
+	assert.Equal(t, "no limits", dd[1].Message)
+	assert.Equal(t, 0, len(render.DiffPopeyeRuns(to, to)))
+	assert.Equal(t, 2, len(render.DiffPopeyeRuns(nil, to)))
+}
+
+func TestPopeyeHistoryRender(t *testing.T) {
+	from := makePopeyeRun(80, render.Issues{{Group: "nginx", Level: pcfg.ErrorLevel, Message: "no limits"}})
+	to := makePopeyeRun(90, render.Issues{{Group: "nginx", Level: pcfg.InfoLevel, Message: "latest tag"}})
+
+	uu := map[string]struct {
+		o render.PopeyeRunInfo
+		e render.Fields
+	}{
+		"first": {
+			o: render.PopeyeRunInfo{Path: "/tmp/r1.json", Run: from},
+			e: render.Fields{"20200102-030405", "all", "80", "B", render.NAValue, render.NAValue, render.NAValue, "1", "2", "3"},
+		},
+		"delta": {
+			o: render.PopeyeRunInfo{Path: "/tmp/r1.json", Run: to, Previous: from},
+			e: render.Fields{"20200102-030405", "all", "90", "B", "+10", "1", "1", "1", "2", "3"},
+		},
+	}
+
+	var p render.PopeyeHistory
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(11)
+			assert.Nil(t, p.Render(u.o, "", &r))
+			assert.Equal(t, "/tmp/r1.json", r.ID)
+			assert.Equal(t, u.e, r.Fields[:10])
+		})
+	}
+}
+
+func TestPopeyeDiffRender(t *testing.T) {
+	var p render.PopeyeDiff
+	r := render.NewRow(6)
+	d := render.IssueDiff{
+		Section:  "pod",
+		Resource: "default/fred",
+		Group:    "__root__",
+		Level:    pcfg.WarnLevel,
+		Message:  "no probes",
+		Change:   render.IssueResolved,
+	}
+	assert.Nil(t, p.Render(d, "", &r))
+	assert.Equal(t, render.Fields{"pod", "default/fred", render.NAValue, "RESOLVED", "WARNING", "no probes"}, r.Fields)
+}
+
+// Helpers...
+
+func makePopeyeRun(score int, ii render.Issues) *render.PopeyeRun {
+	return &render.PopeyeRun{
+		Timestamp: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Cluster:   "fred",
+		Score:     score,
+		Grade:     "B",
+		Sections: render.Sections{
+			{
+				Title:   "pod",
+				GVR:     "v1/pods",
+				Tally:   &render.Tally{OK: 4, Info: 3, Warning: 2, Error: 1},
+				Outcome: render.Outcome{"default/fred": ii},
+			},
+		},
+	}
+}
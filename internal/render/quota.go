@@ -0,0 +1,212 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Quota renders a namespace quota utilization to screen.
+type Quota struct{}
+
+// ColorerFunc colors a resource row.
+func (q Quota) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		nameCol := h.IndexOf("NAME", true)
+		if nameCol >= 0 && strings.TrimSpace(re.Row.Fields[nameCol]) == MissingValue {
+			return PendingColor
+		}
+
+		return DefaultColorer(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (Quota) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "USED", Align: tview.AlignRight},
+		HeaderColumn{Name: "HARD", Align: tview.AlignRight},
+		HeaderColumn{Name: "%USED", Align: tview.AlignRight},
+		HeaderColumn{Name: "REQUESTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "LIMITS", Align: tview.AlignRight},
+		HeaderColumn{Name: "USAGE", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "DEFAULT(R:L)", Wide: true},
+		HeaderColumn{Name: "MAX", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (q Quota) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(QuotaRes)
+	if !ok {
+		return fmt.Errorf("Expected QuotaRes, but got %T", o)
+	}
+
+	name, used, hard, perc := MissingValue, NAValue, NAValue, NAValue
+	if res.Name != "" {
+		name, used, hard = res.Name, res.Used.String(), res.Hard.String()
+		perc = client.ToPercentageStr(res.Used.MilliValue(), res.Hard.MilliValue())
+	}
+	req, lim, usage := res.Load.Gauges(res.Resource)
+	def, max := res.limitRangeFor(res.Resource)
+
+	r.ID = client.FQN(res.Namespace, strings.Join([]string{name, string(res.Resource)}, ":"))
+	r.Fields = Fields{
+		res.Namespace,
+		name,
+		string(res.Resource),
+		used,
+		hard,
+		perc,
+		req,
+		lim,
+		usage,
+		def,
+		max,
+		asStatus(q.diagnose(res)),
+		toAge(res.Age),
+	}
+
+	return nil
+}
+
+func (Quota) diagnose(res QuotaRes) error {
+	if res.Name == "" || res.Hard.IsZero() {
+		return nil
+	}
+	if res.Used.Cmp(res.Hard) >= 0 {
+		return errors.New("quota exhausted")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// QuotaRes represents a single quota resource utilization.
+type QuotaRes struct {
+	Namespace  string
+	Name       string
+	Resource   v1.ResourceName
+	Hard, Used resource.Quantity
+	Load       *NamespaceLoad
+	LimitRange *v1.LimitRange
+	Age        metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (QuotaRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (q QuotaRes) DeepCopyObject() runtime.Object {
+	return q
+}
+
+// limitRangeFor returns the container default request:limit and max for a resource.
+func (q QuotaRes) limitRangeFor(rn v1.ResourceName) (string, string) {
+	if q.LimitRange == nil {
+		return MissingValue, MissingValue
+	}
+	rn = computeResource(rn)
+	for _, l := range q.LimitRange.Spec.Limits {
+		if l.Type != v1.LimitTypeContainer {
+			continue
+		}
+		def := quantityStr(l.DefaultRequest, rn) + ":" + quantityStr(l.Default, rn)
+		return def, quantityStr(l.Max, rn)
+	}
+
+	return MissingValue, MissingValue
+}
+
+// ComputeUsage tracks cpu and memory quantities.
+type ComputeUsage struct {
+	CPU, MEM resource.Quantity
+}
+
+// NamespaceLoad tracks active pods requests, limits and usage in a namespace.
+type NamespaceLoad struct {
+	Pods       int
+	Requests   ComputeUsage
+	Limits     ComputeUsage
+	Usage      ComputeUsage
+	HasMetrics bool
+}
+
+// NewNamespaceLoad returns a new instance.
+func NewNamespaceLoad() *NamespaceLoad {
+	return &NamespaceLoad{}
+}
+
+// AddPod accumulates a pod requests and limits.
+func (n *NamespaceLoad) AddPod(po *v1.Pod) {
+	n.Pods++
+	rcpu, rmem := podRequests(po.Spec)
+	n.Requests.CPU.Add(rcpu)
+	n.Requests.MEM.Add(rmem)
+	lcpu, lmem := podLimits(po.Spec)
+	n.Limits.CPU.Add(lcpu)
+	n.Limits.MEM.Add(lmem)
+}
+
+// Gauges returns the requests, limits and usage matching a quota resource.
+func (n *NamespaceLoad) Gauges(rn v1.ResourceName) (string, string, string) {
+	if n == nil {
+		n = NewNamespaceLoad()
+	}
+
+	switch computeResource(rn) {
+	case v1.ResourceCPU:
+		return toMc(n.Requests.CPU.MilliValue()), toMc(n.Limits.CPU.MilliValue()), n.usage(toMc(n.Usage.CPU.MilliValue()))
+	case v1.ResourceMemory:
+		return toMi(n.Requests.MEM.Value()), toMi(n.Limits.MEM.Value()), n.usage(toMi(n.Usage.MEM.Value()))
+	case v1.ResourcePods:
+		return strconv.Itoa(n.Pods), NAValue, NAValue
+	default:
+		return NAValue, NAValue, NAValue
+	}
+}
+
+func (n *NamespaceLoad) usage(s string) string {
+	if !n.HasMetrics {
+		return NAValue
+	}
+	return s
+}
+
+// computeResource normalizes quota resource names ie requests.cpu -> cpu.
+func computeResource(rn v1.ResourceName) v1.ResourceName {
+	s := strings.TrimPrefix(string(rn), "requests.")
+	s = strings.TrimPrefix(s, "limits.")
+
+	return v1.ResourceName(s)
+}
+
+func quantityStr(rl v1.ResourceList, rn v1.ResourceName) string {
+	q, ok := rl[rn]
+	if !ok {
+		return MissingValue
+	}
+	return q.String()
+}
@@ -0,0 +1,104 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestQuotaRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.QuotaRes
+		id  string
+		e   render.Fields
+	}{
+		"cpu": {
+			res: render.QuotaRes{
+				Namespace:  "fred",
+				Name:       "compute",
+				Resource:   v1.ResourceName("requests.cpu"),
+				Hard:       resource.MustParse("2"),
+				Used:       resource.MustParse("1500m"),
+				Load:       makeNamespaceLoad(),
+				LimitRange: makeLimitRange(),
+			},
+			id: "fred/compute:requests.cpu",
+			e:  render.Fields{"fred", "compute", "requests.cpu", "1500m", "2", "75", "1500", "3000", "100", "100m:1", "2", ""},
+		},
+		"memory": {
+			res: render.QuotaRes{
+				Namespace: "fred",
+				Name:      "compute",
+				Resource:  v1.ResourceLimitsMemory,
+				Hard:      resource.MustParse("1Gi"),
+				Used:      resource.MustParse("1Gi"),
+				Load:      makeNamespaceLoad(),
+			},
+			id: "fred/compute:limits.memory",
+			e:  render.Fields{"fred", "compute", "limits.memory", "1Gi", "1Gi", "100", "512", "1024", "256", "<none>", "<none>", "quota exhausted"},
+		},
+		"pods": {
+			res: render.QuotaRes{
+				Namespace: "fred",
+				Name:      "compute",
+				Resource:  v1.ResourcePods,
+				Hard:      resource.MustParse("10"),
+				Used:      resource.MustParse("2"),
+				Load:      makeNamespaceLoad(),
+			},
+			id: "fred/compute:pods",
+			e:  render.Fields{"fred", "compute", "pods", "2", "10", "20", "2", "n/a", "n/a", "<none>", "<none>", ""},
+		},
+		"limitRangeOnly": {
+			res: render.QuotaRes{
+				Namespace:  "fred",
+				Resource:   v1.ResourceCPU,
+				LimitRange: makeLimitRange(),
+			},
+			id: "fred/<none>:cpu",
+			e:  render.Fields{"fred", "<none>", "cpu", "n/a", "n/a", "n/a", "0", "0", "n/a", "100m:1", "2", ""},
+		},
+	}
+
+	var q render.Quota
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, q.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(r.Fields)-1])
+		})
+	}
+}
+
+// Helpers...
+
+func makeNamespaceLoad() *render.NamespaceLoad {
+	l := render.NewNamespaceLoad()
+	l.Pods = 2
+	l.Requests.CPU, l.Requests.MEM = resource.MustParse("1500m"), resource.MustParse("512Mi")
+	l.Limits.CPU, l.Limits.MEM = resource.MustParse("3"), resource.MustParse("1Gi")
+	l.Usage.CPU, l.Usage.MEM = resource.MustParse("100m"), resource.MustParse("256Mi")
+	l.HasMetrics = true
+
+	return l
+}
+
+func makeLimitRange() *v1.LimitRange {
+	return &v1.LimitRange{
+		Spec: v1.LimitRangeSpec{
+			Limits: []v1.LimitRangeItem{
+				{
+					Type:           v1.LimitTypeContainer,
+					DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+					Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+					Max:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				},
+			},
+		},
+	}
+}
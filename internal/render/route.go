@@ -0,0 +1,143 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-infra/osc/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Route renders an ingress route to its backend service and pods to screen.
+type Route struct{}
+
+// ColorerFunc colors a resource row.
+func (Route) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Route) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "INGRESS"},
+		HeaderColumn{Name: "HOST"},
+		HeaderColumn{Name: "PATH"},
+		HeaderColumn{Name: "SERVICE"},
+		HeaderColumn{Name: "PORT"},
+		HeaderColumn{Name: "TARGET"},
+		HeaderColumn{Name: "ENDPOINTS"},
+		HeaderColumn{Name: "PODS", Wide: true},
+		HeaderColumn{Name: "NOT-READY", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Route) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(RouteRes)
+	if !ok {
+		return fmt.Errorf("Expected RouteRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Namespace,
+		res.Ingress,
+		res.Host,
+		res.Path,
+		res.Service,
+		res.Port,
+		missing(res.TargetPort),
+		res.endpoints(),
+		missing(strings.Join(res.Ready, ",")),
+		missing(strings.Join(res.NotReady, ",")),
+		asStatus(res.Diagnose()),
+		toAge(res.Created),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RouteRes represents an ingress host/path route to a service backend.
+type RouteRes struct {
+	Namespace, Ingress string
+	Index              int
+	Host, Path         string
+	TLS                bool
+	Address            string
+	Service, Port      string
+	TargetPort         string
+	ExternalName       bool
+	Ready, NotReady    []string
+	Err                error
+	Created            metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (RouteRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RouteRes) DeepCopyObject() runtime.Object {
+	return r
+}
+
+// ID returns the route identifier.
+func (r RouteRes) ID() string {
+	return client.FQN(r.Namespace, r.Ingress+":"+strconv.Itoa(r.Index))
+}
+
+// ServiceFQN returns the route backend service fully qualified name.
+func (r RouteRes) ServiceFQN() string {
+	return client.FQN(r.Namespace, r.Service)
+}
+
+// Diagnose returns an error if the route is broken.
+func (r RouteRes) Diagnose() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if r.ExternalName {
+		return nil
+	}
+	if len(r.Ready) == 0 {
+		return errors.New("no ready endpoints")
+	}
+
+	return nil
+}
+
+// URL returns the route url using the ingress host or address for wildcard hosts.
+func (r RouteRes) URL() (string, error) {
+	host := r.Host
+	if strings.HasPrefix(host, "*") {
+		host = r.Address
+	}
+	if host == "" {
+		return "", fmt.Errorf("no host or address found for route %s", r.ID())
+	}
+	scheme := "http"
+	if r.TLS {
+		scheme = "https"
+	}
+
+	return scheme + "://" + host + r.Path, nil
+}
+
+func (r RouteRes) endpoints() string {
+	if r.ExternalName {
+		return NAValue
+	}
+
+	return strconv.Itoa(len(r.Ready)) + "/" + strconv.Itoa(len(r.Ready)+len(r.NotReady))
+}
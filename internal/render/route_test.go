@@ -0,0 +1,126 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.RouteRes
+		id  string
+		e   render.Fields
+	}{
+		"healthy": {
+			res: render.RouteRes{
+				Namespace:  "default",
+				Ingress:    "fred",
+				Index:      1,
+				Host:       "fred.io",
+				Path:       "/api",
+				Service:    "blee",
+				Port:       "http",
+				TargetPort: "8080",
+				Ready:      []string{"blee-1", "blee-2"},
+				NotReady:   []string{"blee-3"},
+			},
+			id: "default/fred:1",
+			e:  render.Fields{"default", "fred", "fred.io", "/api", "blee", "http", "8080", "2/3", "blee-1,blee-2", "blee-3", ""},
+		},
+		"noEndpoints": {
+			res: render.RouteRes{
+				Namespace:  "default",
+				Ingress:    "fred",
+				Host:       "*",
+				Path:       "/",
+				Service:    "blee",
+				Port:       "80",
+				TargetPort: "8080",
+			},
+			id: "default/fred:0",
+			e:  render.Fields{"default", "fred", "*", "/", "blee", "80", "8080", "0/0", "<none>", "<none>", "no ready endpoints"},
+		},
+		"missingService": {
+			res: render.RouteRes{
+				Namespace: "default",
+				Ingress:   "fred",
+				Host:      "fred.io",
+				Path:      "/",
+				Service:   "blee",
+				Port:      "80",
+				Err:       errors.New("service blee not found"),
+			},
+			id: "default/fred:0",
+			e:  render.Fields{"default", "fred", "fred.io", "/", "blee", "80", "<none>", "0/0", "<none>", "<none>", "service blee not found"},
+		},
+		"externalName": {
+			res: render.RouteRes{
+				Namespace:    "default",
+				Ingress:      "fred",
+				Host:         "fred.io",
+				Path:         "/",
+				Service:      "blee",
+				Port:         "80",
+				TargetPort:   "blee.example.com",
+				ExternalName: true,
+			},
+			id: "default/fred:0",
+			e:  render.Fields{"default", "fred", "fred.io", "/", "blee", "80", "blee.example.com", "n/a", "<none>", "<none>", ""},
+		},
+	}
+
+	var r render.Route
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row render.Row
+			assert.Nil(t, r.Render(u.res, "", &row))
+			assert.Equal(t, u.id, row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
+
+func TestRouteURL(t *testing.T) {
+	uu := map[string]struct {
+		res render.RouteRes
+		e   string
+		err bool
+	}{
+		"host": {
+			res: render.RouteRes{Host: "fred.io", Path: "/api"},
+			e:   "http://fred.io/api",
+		},
+		"tls": {
+			res: render.RouteRes{Host: "fred.io", Path: "/", TLS: true},
+			e:   "https://fred.io/",
+		},
+		"wildcard": {
+			res: render.RouteRes{Host: "*", Path: "/", Address: "10.0.0.1"},
+			e:   "http://10.0.0.1/",
+		},
+		"noAddress": {
+			res: render.RouteRes{Host: "*.fred.io", Path: "/"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			url, err := u.res.URL()
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, url)
+		})
+	}
+}
+
+func TestRouteHeader(t *testing.T) {
+	h := render.Route{}.Header("")
+
+	assert.Equal(t, 12, len(h))
+	assert.Equal(t, 7, h.IndexOf("ENDPOINTS", false))
+}
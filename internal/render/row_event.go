@@ -184,6 +184,16 @@ func (r RowEvents) Clear() RowEvents {
 	return RowEvents{}
 }
 
+// IndexByID maps rows ids to their index.
+func (r RowEvents) IndexByID() map[string]int {
+	mm := make(map[string]int, len(r))
+	for i, re := range r {
+		mm[re.Row.ID] = i
+	}
+
+	return mm
+}
+
 // FindIndex locates a row index by id. Returns false is not found.
 func (r RowEvents) FindIndex(id string) (int, bool) {
 	for i, re := range r {
@@ -0,0 +1,151 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Storage renders a volume and its claim and mounting pods to screen.
+type Storage struct{}
+
+// ColorerFunc colors a resource row.
+func (Storage) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Storage) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "CLAIM"},
+		HeaderColumn{Name: "VOLUME"},
+		HeaderColumn{Name: "PV-STATUS"},
+		HeaderColumn{Name: "PVC-STATUS"},
+		HeaderColumn{Name: "CAPACITY", Align: tview.AlignRight},
+		HeaderColumn{Name: "USED", Align: tview.AlignRight},
+		HeaderColumn{Name: "%USED", Align: tview.AlignRight},
+		HeaderColumn{Name: "PODS"},
+		HeaderColumn{Name: "STORAGECLASS"},
+		HeaderColumn{Name: "RECLAIM POLICY", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Storage) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(StorageRes)
+	if !ok {
+		return fmt.Errorf("Expected StorageRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		missing(res.Namespace),
+		missing(res.Claim),
+		missing(res.Volume),
+		missing(res.PVPhase),
+		missing(res.PVCPhase),
+		ToBytes(res.Capacity),
+		ToBytes(res.Used),
+		res.usedPerc(),
+		missing(strings.Join(res.Pods, ",")),
+		missing(res.StorageClass),
+		missing(res.Reclaim),
+		asStatus(res.Diagnose()),
+		toAge(res.Created),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// StorageRes represents a volume linked to its claim and mounting pods.
+// Unknown sizes are negative.
+type StorageRes struct {
+	Namespace, Claim  string
+	Volume            string
+	PVPhase, PVCPhase string
+	Capacity, Used    int64
+	Pods              []string
+	StorageClass      string
+	Reclaim           string
+	Created           metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (StorageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s StorageRes) DeepCopyObject() runtime.Object {
+	return s
+}
+
+// ID returns the volume name or the claim fully qualified name if the claim
+// is not bound.
+func (s StorageRes) ID() string {
+	if s.Volume != "" {
+		return s.Volume
+	}
+
+	return client.FQN(s.Namespace, s.Claim)
+}
+
+// Diagnose returns an error if the volume or claim is orphaned or broken.
+func (s StorageRes) Diagnose() error {
+	switch {
+	case s.PVPhase == string(v1.VolumeReleased):
+		return errors.New("released volume")
+	case s.PVPhase == string(v1.VolumeFailed):
+		return errors.New("failed volume")
+	case s.Claim != "" && s.PVCPhase == "":
+		return errors.New("claim not found")
+	case s.PVCPhase == string(v1.ClaimLost):
+		return errors.New("lost claim")
+	case s.PVCPhase == string(v1.ClaimPending):
+		return errors.New("unbound claim")
+	case s.PVCPhase == string(v1.ClaimBound) && len(s.Pods) == 0:
+		return errors.New("claim not mounted")
+	default:
+		return nil
+	}
+}
+
+func (s StorageRes) usedPerc() string {
+	if s.Used < 0 || s.Capacity <= 0 {
+		return NAValue
+	}
+
+	return PrintPerc(int(s.Used * 100 / s.Capacity))
+}
+
+// ToBytes returns a human readable binary size or n/a if unknown.
+func ToBytes(n int64) string {
+	if n < 0 {
+		return NAValue
+	}
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return strconv.FormatFloat(float64(n)/float64(div), 'f', 1, 64) + string("KMGTPE"[exp]) + "i"
+}
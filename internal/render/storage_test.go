@@ -0,0 +1,110 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.StorageRes
+		id  string
+		e   render.Fields
+	}{
+		"mounted": {
+			res: render.StorageRes{
+				Namespace:    "default",
+				Claim:        "fred",
+				Volume:       "pv-1",
+				PVPhase:      "Bound",
+				PVCPhase:     "Bound",
+				Capacity:     2 * 1024 * 1024 * 1024,
+				Used:         512 * 1024 * 1024,
+				Pods:         []string{"fred-0", "fred-1"},
+				StorageClass: "standard",
+				Reclaim:      "Delete",
+			},
+			id: "pv-1",
+			e:  render.Fields{"default", "fred", "pv-1", "Bound", "Bound", "2.0Gi", "512.0Mi", "25%", "fred-0,fred-1", "standard", "Delete", ""},
+		},
+		"released": {
+			res: render.StorageRes{
+				Namespace: "default",
+				Claim:     "fred",
+				Volume:    "pv-1",
+				PVPhase:   "Released",
+				Capacity:  1024,
+				Used:      -1,
+				Reclaim:   "Retain",
+			},
+			id: "pv-1",
+			e:  render.Fields{"default", "fred", "pv-1", "Released", "<none>", "1.0Ki", "n/a", "n/a", "<none>", "<none>", "Retain", "released volume"},
+		},
+		"available": {
+			res: render.StorageRes{
+				Volume:   "pv-1",
+				PVPhase:  "Available",
+				Capacity: 100,
+				Used:     -1,
+			},
+			id: "pv-1",
+			e:  render.Fields{"<none>", "<none>", "pv-1", "Available", "<none>", "100", "n/a", "n/a", "<none>", "<none>", "<none>", ""},
+		},
+		"unbound": {
+			res: render.StorageRes{
+				Namespace: "default",
+				Claim:     "fred",
+				PVCPhase:  "Pending",
+				Capacity:  -1,
+				Used:      -1,
+			},
+			id: "default/fred",
+			e:  render.Fields{"default", "fred", "<none>", "<none>", "Pending", "n/a", "n/a", "n/a", "<none>", "<none>", "<none>", "unbound claim"},
+		},
+		"notMounted": {
+			res: render.StorageRes{
+				Namespace: "default",
+				Claim:     "fred",
+				Volume:    "pv-1",
+				PVPhase:   "Bound",
+				PVCPhase:  "Bound",
+				Capacity:  -1,
+				Used:      -1,
+			},
+			id: "pv-1",
+			e:  render.Fields{"default", "fred", "pv-1", "Bound", "Bound", "n/a", "n/a", "n/a", "<none>", "<none>", "<none>", "claim not mounted"},
+		},
+	}
+
+	var r render.Storage
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row render.Row
+			assert.Nil(t, r.Render(u.res, "", &row))
+			assert.Equal(t, u.id, row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
+
+func TestToBytes(t *testing.T) {
+	uu := map[string]struct {
+		n int64
+		e string
+	}{
+		"unknown": {n: -1, e: "n/a"},
+		"bytes":   {n: 512, e: "512"},
+		"kilo":    {n: 1536, e: "1.5Ki"},
+		"giga":    {n: 3 * 1024 * 1024 * 1024, e: "3.0Gi"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToBytes(u.n))
+		})
+	}
+}
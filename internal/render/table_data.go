@@ -1,6 +1,10 @@
 package render
 
-import "github.com/open-infra/osc/internal/client"
+import (
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+)
 
 // TableData tracks a K8s resource for tabular display.
 type TableData struct {
@@ -70,22 +74,11 @@ func (t *TableData) SetHeader(ns string, h Header) {
 func (t *TableData) Update(rows Rows) {
 	empty := len(t.RowEvents) == 0
 	kk := make(map[string]struct{}, len(rows))
-	var blankDelta DeltaRow
+	idx := t.RowEvents.IndexByID()
 	for _, row := range rows {
 		kk[row.ID] = struct{}{}
-		if empty {
-			t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
-			continue
-		}
-
-		if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-			delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
-			if delta.IsBlank() {
-				t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
-				t.RowEvents[index].Row = row
-			} else {
-				t.RowEvents[index] = NewRowEventWithDeltas(row, delta)
-			}
+		if i, ok := idx[row.ID]; ok && !empty {
+			t.UpdateRowAt(i, row)
 			continue
 		}
 		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
@@ -96,18 +89,80 @@ func (t *TableData) Update(rows Rows) {
 	}
 }
 
-// Delete removes items in cache that are no longer valid.
-func (t *TableData) Delete(newKeys map[string]struct{}) {
-	var victims []string
+// UpdateRow adds a new row or computes deltas for an existing row.
+func (t *TableData) UpdateRow(row Row) {
+	index, ok := t.RowEvents.FindIndex(row.ID)
+	if !ok {
+		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
+		return
+	}
+	t.UpdateRowAt(index, row)
+}
+
+// UpdateRowAt computes deltas for the row at a given index.
+func (t *TableData) UpdateRowAt(index int, row Row) {
+	delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
+	if delta.IsBlank() {
+		var blankDelta DeltaRow
+		t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
+		t.RowEvents[index].Row = row
+		return
+	}
+	t.RowEvents[index] = NewRowEventWithDeltas(row, delta)
+}
+
+// DeleteRow removes a given row.
+func (t *TableData) DeleteRow(id string) {
+	t.RowEvents = t.RowEvents.Delete(id)
+}
+
+// DeleteRows removes the rows with the given ids.
+func (t *TableData) DeleteRows(ids map[string]struct{}) {
+	if len(ids) == 0 {
+		return
+	}
+	rr := t.RowEvents[:0]
 	for _, re := range t.RowEvents {
-		if _, ok := newKeys[re.Row.ID]; !ok {
-			victims = append(victims, re.Row.ID)
+		if _, ok := ids[re.Row.ID]; !ok {
+			rr = append(rr, re)
 		}
 	}
+	t.RowEvents = rr
+}
+
+// Settle marks all rows as unchanged.
+func (t *TableData) Settle() {
+	var blankDelta DeltaRow
+	for i := range t.RowEvents {
+		t.RowEvents[i].Kind, t.RowEvents[i].Deltas = EventUnchanged, blankDelta
+	}
+}
 
-	for _, id := range victims {
-		t.RowEvents = t.RowEvents.Delete(id)
+// AgeBy moves the age column forward by a given duration.
+func (t *TableData) AgeBy(d time.Duration) {
+	col := t.Header.IndexOf(ageCol, true)
+	if col < 0 {
+		return
+	}
+	for _, re := range t.RowEvents {
+		if col >= len(re.Row.Fields) {
+			continue
+		}
+		if age, err := time.ParseDuration(re.Row.Fields[col]); err == nil {
+			re.Row.Fields[col] = (age + d).String()
+		}
+	}
+}
+
+// Delete removes items in cache that are no longer valid.
+func (t *TableData) Delete(newKeys map[string]struct{}) {
+	rr := t.RowEvents[:0]
+	for _, re := range t.RowEvents {
+		if _, ok := newKeys[re.Row.ID]; ok {
+			rr = append(rr, re)
+		}
 	}
+	t.RowEvents = rr
 }
 
 // Diff checks if two tables are equal.
@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/open-infra/osc/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -394,3 +395,117 @@ func TestTableDataDelete(t *testing.T) {
 	}
 
 }
+
+func TestTableDataUpdateRow(t *testing.T) {
+	uu := map[string]struct {
+		row render.Row
+		e   render.RowEvents
+	}{
+		"add": {
+			row: render.Row{ID: "D", Fields: render.Fields{"4", "2", "3"}},
+			e: render.RowEvents{
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+				{Kind: render.EventAdd, Row: render.Row{ID: "D", Fields: render.Fields{"4", "2", "3"}}},
+			},
+		},
+		"update": {
+			row: render.Row{ID: "A", Fields: render.Fields{"10", "2", "3"}},
+			e: render.RowEvents{
+				{
+					Kind:   render.EventUpdate,
+					Row:    render.Row{ID: "A", Fields: render.Fields{"10", "2", "3"}},
+					Deltas: render.DeltaRow{"1", "", ""},
+				},
+			},
+		},
+		"same": {
+			row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}},
+			e: render.RowEvents{
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			table := render.TableData{
+				Header: render.Header{
+					render.HeaderColumn{Name: "A"},
+					render.HeaderColumn{Name: "B"},
+					render.HeaderColumn{Name: "C"},
+				},
+				RowEvents: render.RowEvents{
+					{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+				},
+			}
+			table.UpdateRow(u.row)
+			assert.Equal(t, u.e, table.RowEvents)
+		})
+	}
+}
+
+func TestTableDataDeleteRow(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+			{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+		},
+	}
+	table.DeleteRow("A")
+	table.DeleteRow("Z")
+
+	assert.Equal(t, render.RowEvents{
+		{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+	}, table.RowEvents)
+}
+
+func TestTableDataDeleteRows(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+			{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+			{Row: render.Row{ID: "C", Fields: render.Fields{"9", "2", "3"}}},
+		},
+	}
+	assert.Equal(t, map[string]int{"A": 0, "B": 1, "C": 2}, table.RowEvents.IndexByID())
+
+	table.DeleteRows(map[string]struct{}{"A": {}, "C": {}, "Z": {}})
+	assert.Equal(t, render.RowEvents{
+		{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+	}, table.RowEvents)
+}
+
+func TestTableDataSettle(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Kind: render.EventAdd, Row: render.Row{ID: "A", Fields: render.Fields{"1"}}},
+			{Kind: render.EventUpdate, Row: render.Row{ID: "B", Fields: render.Fields{"2"}}, Deltas: render.DeltaRow{"1"}},
+		},
+	}
+	table.Settle()
+
+	for _, re := range table.RowEvents {
+		assert.Equal(t, render.EventUnchanged, re.Kind)
+		assert.Nil(t, re.Deltas)
+	}
+}
+
+func TestTableDataAgeBy(t *testing.T) {
+	table := render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "A"},
+			render.HeaderColumn{Name: "AGE"},
+		},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "A", Fields: render.Fields{"1", "1m0s"}}},
+			{Row: render.Row{ID: "B", Fields: render.Fields{"2", "n/a"}}},
+			{Row: render.Row{ID: "C", Fields: render.Fields{"3"}}},
+		},
+	}
+	table.AgeBy(2 * time.Second)
+
+	assert.Equal(t, "1m2s", table.RowEvents[0].Row.Fields[1])
+	assert.Equal(t, "n/a", table.RowEvents[1].Row.Fields[1])
+	assert.Equal(t, 1, len(table.RowEvents[2].Row.Fields))
+}
@@ -27,6 +27,9 @@ const (
 	// Pending represents a pod pending status.
 	Pending = "Pending"
 
+	// Failed represents a job failed status.
+	Failed = "Failed"
+
 	// Blank represents no value.
 	Blank = ""
 )
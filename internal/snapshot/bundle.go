@@ -0,0 +1,279 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// FormatVersion represents the current snapshot bundle format.
+	FormatVersion = 1
+
+	manifestFile = "manifest.json"
+	docsDir      = "docs/"
+	logsDir      = "logs/"
+	openAPIFile  = "openapi/v2.pb"
+	docExt       = ".json"
+	logExt       = ".log"
+	snapshotUser = "snapshot"
+)
+
+// Manifest describes a snapshot bundle.
+type Manifest struct {
+	Version    int       `json:"version"`
+	Context    string    `json:"context"`
+	Cluster    string    `json:"cluster"`
+	Namespaces []string  `json:"namespaces,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Resources  []string  `json:"resources"`
+	Skipped    []string  `json:"skipped,omitempty"`
+}
+
+// Bundle represents a cluster state captured at a point in time. Documents
+// are keyed by their api server url path.
+type Bundle struct {
+	Manifest Manifest
+
+	docs    map[string][]byte
+	logs    map[string][]byte
+	openAPI []byte
+}
+
+// NewBundle returns a new empty bundle.
+func NewBundle(m Manifest) *Bundle {
+	m.Version = FormatVersion
+	return &Bundle{
+		Manifest: m,
+		docs:     make(map[string][]byte),
+		logs:     make(map[string][]byte),
+	}
+}
+
+// Doc returns an api document at the given url path.
+func (b *Bundle) Doc(p string) ([]byte, bool) {
+	raw, ok := b.docs[p]
+	return raw, ok
+}
+
+// AddDoc registers an api document at the given url path.
+func (b *Bundle) AddDoc(p string, raw []byte) {
+	b.docs[p] = raw
+}
+
+// Log returns a container captured logs.
+func (b *Bundle) Log(ns, pod, co string) ([]byte, bool) {
+	raw, ok := b.logs[logKey(ns, pod, co)]
+	return raw, ok
+}
+
+// PodLogs returns the names of the containers with captured logs for a pod.
+func (b *Bundle) PodLogs(ns, pod string) []string {
+	prefix := logKey(ns, pod, "")
+	var cc []string
+	for k := range b.logs {
+		if strings.HasPrefix(k, prefix) {
+			cc = append(cc, strings.TrimPrefix(k, prefix))
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+// AddLog registers a container logs.
+func (b *Bundle) AddLog(ns, pod, co string, raw []byte) {
+	b.logs[logKey(ns, pod, co)] = raw
+}
+
+// OpenAPI returns the api server OpenAPI v2 spec in protobuf form.
+func (b *Bundle) OpenAPI() []byte {
+	return b.openAPI
+}
+
+// SetOpenAPI registers the api server OpenAPI v2 spec.
+func (b *Bundle) SetOpenAPI(raw []byte) {
+	b.openAPI = raw
+}
+
+// Server returns a fake api server url unique to this snapshot.
+func (b *Bundle) Server() string {
+	return fmt.Sprintf("http://osc-snapshot-%d", b.Manifest.CreatedAt.Unix())
+}
+
+// Write writes out the bundle as a gzipped tarball.
+func (b *Bundle) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	raw, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(tw, manifestFile, raw, b.Manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, k := range sortedKeys(b.docs) {
+		if err := writeEntry(tw, docsDir+strings.TrimPrefix(k, "/")+docExt, b.docs[k], b.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	for _, k := range sortedKeys(b.logs) {
+		if err := writeEntry(tw, logsDir+k+logExt, b.logs[k], b.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if len(b.openAPI) > 0 {
+		if err := writeEntry(tw, openAPIFile, b.openAPI, b.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// Save writes out the bundle to the given file.
+func (b *Bundle) Save(file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := b.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Read loads a bundle from a gzipped tarball.
+func Read(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	b, manifest := NewBundle(Manifest{}), false
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		raw, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		switch name := path.Clean(h.Name); {
+		case name == manifestFile:
+			if err := json.Unmarshal(raw, &b.Manifest); err != nil {
+				return nil, fmt.Errorf("invalid snapshot manifest: %v", err)
+			}
+			manifest = true
+		case name == openAPIFile:
+			b.openAPI = raw
+		case strings.HasPrefix(name, docsDir) && strings.HasSuffix(name, docExt):
+			b.docs["/"+strings.TrimSuffix(strings.TrimPrefix(name, docsDir), docExt)] = raw
+		case strings.HasPrefix(name, logsDir) && strings.HasSuffix(name, logExt):
+			b.logs[strings.TrimSuffix(strings.TrimPrefix(name, logsDir), logExt)] = raw
+		}
+	}
+	if !manifest {
+		return nil, fmt.Errorf("no %s found. Not a snapshot bundle", manifestFile)
+	}
+	if b.Manifest.Version > FormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", b.Manifest.Version)
+	}
+
+	return b, nil
+}
+
+// Load loads a bundle from the given file.
+func Load(file string) (*Bundle, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// WriteKubeConfig writes out a kubeconfig pointing the snapshot context to the
+// snapshot fake api server.
+func (b *Bundle) WriteKubeConfig(file string) error {
+	m := b.Manifest
+	ctx := clientcmdv1.Context{Cluster: m.Cluster, AuthInfo: snapshotUser}
+	if len(m.Namespaces) == 1 {
+		ctx.Namespace = m.Namespaces[0]
+	}
+	cfg := clientcmdv1.Config{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []clientcmdv1.NamedCluster{{Name: m.Cluster, Cluster: clientcmdv1.Cluster{Server: b.Server()}}},
+		AuthInfos:      []clientcmdv1.NamedAuthInfo{{Name: snapshotUser}},
+		Contexts:       []clientcmdv1.NamedContext{{Name: m.Context, Context: ctx}},
+		CurrentContext: m.Context,
+	}
+	raw, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, raw, 0600)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func writeEntry(tw *tar.Writer, name string, raw []byte, t time.Time) error {
+	h := tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(raw)),
+		ModTime:  t,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, bytes.NewReader(raw))
+
+	return err
+}
+
+func logKey(ns, pod, co string) string {
+	return ns + "/" + pod + "/" + co
+}
+
+func sortedKeys(mm map[string][]byte) []string {
+	kk := make([]string, 0, len(mm))
+	for k := range mm {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,67 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-infra/osc/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	b := makeBundle()
+	b.SetOpenAPI([]byte("spec"))
+
+	var buff bytes.Buffer
+	assert.Nil(t, b.Write(&buff))
+	o, err := snapshot.Read(&buff)
+	assert.Nil(t, err)
+
+	assert.Equal(t, b.Manifest, o.Manifest)
+	assert.Equal(t, snapshot.FormatVersion, o.Manifest.Version)
+	for k, v := range testDocs {
+		raw, ok := o.Doc(k)
+		assert.True(t, ok, k)
+		assert.Equal(t, v, string(raw))
+	}
+	raw, ok := o.Log("default", "fred", "c1")
+	assert.True(t, ok)
+	assert.Equal(t, "l1\nl2\nl3\n", string(raw))
+	assert.Equal(t, []string{"c1"}, o.PodLogs("default", "fred"))
+	assert.Equal(t, "spec", string(o.OpenAPI()))
+}
+
+func TestBundleSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snap.tar.gz")
+	assert.Nil(t, makeBundle().Save(path))
+
+	b, err := snapshot.Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", b.Manifest.Context)
+}
+
+func TestBundleReadInvalid(t *testing.T) {
+	b := makeBundle()
+	b.Manifest.Version = snapshot.FormatVersion + 1
+	var buff bytes.Buffer
+	assert.Nil(t, b.Write(&buff))
+	_, err := snapshot.Read(&buff)
+	assert.NotNil(t, err)
+
+	_, err = snapshot.Read(bytes.NewBufferString("blee"))
+	assert.NotNil(t, err)
+}
+
+func TestBundleWriteKubeConfig(t *testing.T) {
+	b := makeBundle()
+	path := filepath.Join(t.TempDir(), "config")
+	assert.Nil(t, b.WriteKubeConfig(path))
+
+	cfg, err := clientcmd.LoadFromFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", cfg.CurrentContext)
+	assert.Equal(t, "zorg", cfg.Contexts["fred"].Cluster)
+	assert.Equal(t, b.Server(), cfg.Clusters["zorg"].Server)
+}
@@ -0,0 +1,316 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	// DefaultTailLines represents the number of log lines captured per container.
+	DefaultTailLines = 100
+
+	captureTimeout  = 30 * time.Second
+	logWorkers      = 8
+	openAPIProtobuf = "application/com.github.proto-openapi.spec.v2@v1.0+protobuf"
+	lastApplied     = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Options represents snapshot capture options.
+type Options struct {
+	// Namespaces to capture. Blank captures all namespaces.
+	Namespaces []string
+
+	// TailLines represents the number of log lines to capture per container.
+	TailLines int64
+
+	// Progress gets notified as resources are captured.
+	Progress func(gvr string, count int, err error)
+}
+
+// Capture captures all listable resources, recent logs and metrics for the
+// given namespaces. Secrets data is redacted.
+func Capture(ctx context.Context, conn client.Connection, opts Options) (*Bundle, error) {
+	dial, err := conn.Dial()
+	if err != nil {
+		return nil, err
+	}
+	m := Manifest{CreatedAt: time.Now(), Namespaces: opts.Namespaces}
+	if m.Context, err = conn.Config().CurrentContextName(); err != nil {
+		return nil, err
+	}
+	if m.Cluster, err = conn.Config().CurrentClusterName(); err != nil {
+		return nil, err
+	}
+
+	c := capturer{
+		rest:   dial.Discovery().RESTClient(),
+		dial:   dial,
+		bundle: NewBundle(m),
+		opts:   opts,
+	}
+	if err := c.discover(ctx); err != nil {
+		return nil, err
+	}
+	c.captureLogs(ctx)
+	if raw, err := c.get(ctx, "/openapi/v2", openAPIProtobuf); err == nil {
+		c.bundle.SetOpenAPI(raw)
+	} else {
+		log.Warn().Err(err).Msg("OpenAPI spec capture failed")
+	}
+
+	return c.bundle, nil
+}
+
+type capturer struct {
+	rest   restclient.Interface
+	dial   kubernetes.Interface
+	bundle *Bundle
+	opts   Options
+	pods   []unstructured.Unstructured
+}
+
+func (c *capturer) discover(ctx context.Context) error {
+	for _, p := range []string{"/version", "/api", "/apis"} {
+		if _, err := c.doc(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	var paths []string
+	var vv metav1.APIVersions
+	if err := c.decode("/api", &vv); err != nil {
+		return err
+	}
+	for _, v := range vv.Versions {
+		paths = append(paths, "/api/"+v)
+	}
+	var gg metav1.APIGroupList
+	if err := c.decode("/apis", &gg); err != nil {
+		return err
+	}
+	for _, g := range gg.Groups {
+		for _, v := range g.Versions {
+			paths = append(paths, "/apis/"+v.GroupVersion)
+		}
+	}
+
+	for _, p := range paths {
+		raw, err := c.doc(ctx, p)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Discovery failed for %q", p)
+			continue
+		}
+		var rr metav1.APIResourceList
+		if err := json.Unmarshal(raw, &rr); err != nil {
+			return err
+		}
+		for _, r := range rr.APIResources {
+			if strings.Contains(r.Name, "/") || !hasVerb(r.Verbs, "list") {
+				continue
+			}
+			c.captureList(ctx, p, rr.GroupVersion, r)
+		}
+	}
+
+	return nil
+}
+
+func (c *capturer) captureList(ctx context.Context, prefix, gv string, r metav1.APIResource) {
+	gvr := strings.TrimPrefix(gv+"/"+r.Name, "/")
+	list := unstructured.UnstructuredList{}
+	list.SetAPIVersion(gv)
+	list.SetKind(r.Kind + "List")
+
+	err := c.list(ctx, prefix, r, &list)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Capture failed for %q", gvr)
+		c.bundle.Manifest.Skipped = append(c.bundle.Manifest.Skipped, gvr)
+		list.Items = nil
+	} else {
+		c.bundle.Manifest.Resources = append(c.bundle.Manifest.Resources, gvr)
+	}
+	switch gvr {
+	case "v1/secrets":
+		redactSecrets(&list)
+	case "v1/namespaces":
+		list.Items = filterNamespaces(list.Items, c.opts.Namespaces)
+	case "v1/pods":
+		c.pods = list.Items
+	}
+	if c.opts.Progress != nil {
+		c.opts.Progress(gvr, len(list.Items), err)
+	}
+
+	raw, merr := list.MarshalJSON()
+	if merr != nil {
+		log.Error().Err(merr).Msgf("Marshal failed for %q", gvr)
+		return
+	}
+	c.bundle.AddDoc(prefix+"/"+r.Name, raw)
+}
+
+func (c *capturer) list(ctx context.Context, prefix string, r metav1.APIResource, list *unstructured.UnstructuredList) error {
+	if !r.Namespaced || len(c.opts.Namespaces) == 0 {
+		return c.decodeList(ctx, prefix+"/"+r.Name, list)
+	}
+	for _, ns := range c.opts.Namespaces {
+		var l unstructured.UnstructuredList
+		if err := c.decodeList(ctx, prefix+"/namespaces/"+ns+"/"+r.Name, &l); err != nil {
+			return err
+		}
+		list.Items = append(list.Items, l.Items...)
+		list.SetResourceVersion(l.GetResourceVersion())
+	}
+
+	return nil
+}
+
+func (c *capturer) decodeList(ctx context.Context, p string, list *unstructured.UnstructuredList) error {
+	raw, err := c.get(ctx, p, "")
+	if err != nil {
+		return err
+	}
+	apiVersion, kind := list.GetAPIVersion(), list.GetKind()
+	if err := list.UnmarshalJSON(raw); err != nil {
+		return err
+	}
+	list.SetAPIVersion(apiVersion)
+	list.SetKind(kind)
+
+	return nil
+}
+
+func (c *capturer) captureLogs(ctx context.Context) {
+	type job struct{ ns, pod, co string }
+	jobs := make(chan job)
+	var (
+		wg sync.WaitGroup
+		mx sync.Mutex
+	)
+	for i := 0; i < logWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				raw, err := c.podLogs(ctx, j.ns, j.pod, j.co)
+				if err != nil {
+					log.Debug().Err(err).Msgf("Logs capture failed for %s/%s:%s", j.ns, j.pod, j.co)
+					continue
+				}
+				mx.Lock()
+				c.bundle.AddLog(j.ns, j.pod, j.co, raw)
+				mx.Unlock()
+			}
+		}()
+	}
+	for _, po := range c.pods {
+		cc, _, _ := unstructured.NestedSlice(po.Object, "spec", "containers")
+		for _, co := range cc {
+			if n, ok := co.(map[string]interface{})["name"].(string); ok {
+				jobs <- job{ns: po.GetNamespace(), pod: po.GetName(), co: n}
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func (c *capturer) podLogs(ctx context.Context, ns, pod, co string) ([]byte, error) {
+	opts := v1.PodLogOptions{Container: co}
+	if c.opts.TailLines > 0 {
+		opts.TailLines = &c.opts.TailLines
+	}
+	ctx, cancel := context.WithTimeout(ctx, captureTimeout)
+	defer cancel()
+
+	return c.dial.CoreV1().Pods(ns).GetLogs(pod, &opts).DoRaw(ctx)
+}
+
+func (c *capturer) doc(ctx context.Context, p string) ([]byte, error) {
+	raw, err := c.get(ctx, p, "")
+	if err != nil {
+		return nil, err
+	}
+	c.bundle.AddDoc(p, raw)
+
+	return raw, nil
+}
+
+func (c *capturer) decode(p string, o interface{}) error {
+	raw, _ := c.bundle.Doc(p)
+	return json.Unmarshal(raw, o)
+}
+
+func (c *capturer) get(ctx context.Context, p, accept string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, captureTimeout)
+	defer cancel()
+
+	req := c.rest.Get().AbsPath(p)
+	if accept != "" {
+		req.SetHeader("Accept", accept)
+	}
+
+	return req.DoRaw(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hasVerb(vv []string, verb string) bool {
+	for _, v := range vv {
+		if v == verb {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactSecrets blanks out secrets values while preserving their keys.
+func redactSecrets(list *unstructured.UnstructuredList) {
+	for i := range list.Items {
+		o := list.Items[i].Object
+		for _, k := range []string{"data", "stringData"} {
+			data, ok := o[k].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key := range data {
+				data[key] = ""
+			}
+		}
+		if aa := list.Items[i].GetAnnotations(); aa != nil {
+			delete(aa, lastApplied)
+			list.Items[i].SetAnnotations(aa)
+		}
+	}
+}
+
+func filterNamespaces(oo []unstructured.Unstructured, nns []string) []unstructured.Unstructured {
+	if len(nns) == 0 {
+		return oo
+	}
+	keep := make(map[string]struct{}, len(nns))
+	for _, ns := range nns {
+		keep[ns] = struct{}{}
+	}
+	res := make([]unstructured.Unstructured, 0, len(nns))
+	for _, o := range oo {
+		if _, ok := keep[o.GetName()]; ok {
+			res = append(res, o)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,70 @@
+package snapshot_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-infra/osc/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+func TestCapture(t *testing.T) {
+	cfg := makeConfig(t, makeBundle())
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	dial, err := kubernetes.NewForConfig(rc)
+	assert.Nil(t, err)
+
+	var captured []string
+	opts := snapshot.Options{
+		Namespaces: []string{"default"},
+		TailLines:  1,
+		Progress: func(gvr string, count int, err error) {
+			captured = append(captured, gvr)
+		},
+	}
+	b, err := snapshot.Capture(context.Background(), testConn{cfg: cfg, dial: dial}, opts)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "fred", b.Manifest.Context)
+	assert.Equal(t, "zorg", b.Manifest.Cluster)
+	assert.Equal(t, []string{"v1/namespaces", "v1/pods", "v1/secrets"}, b.Manifest.Resources)
+	assert.Equal(t, b.Manifest.Resources, captured)
+	assert.Equal(t, []string{"fred"}, docNames(t, b, "/api/v1/pods"))
+	assert.Equal(t, []string{"default"}, docNames(t, b, "/api/v1/namespaces"))
+
+	raw, ok := b.Log("default", "fred", "c1")
+	assert.True(t, ok)
+	assert.Equal(t, "l3\n", string(raw))
+	_, ok = b.Log("ns1", "blee", "c1")
+	assert.False(t, ok)
+
+	secrets := docList(t, b, "/api/v1/secrets")
+	assert.Equal(t, 1, len(secrets.Items))
+	data, _, _ := unstructured.NestedStringMap(secrets.Items[0].Object, "data")
+	assert.Equal(t, map[string]string{"pwd": ""}, data)
+	assert.Empty(t, secrets.Items[0].GetAnnotations())
+}
+
+// Helpers...
+
+func docList(t *testing.T, b *snapshot.Bundle, p string) *unstructured.UnstructuredList {
+	raw, ok := b.Doc(p)
+	assert.True(t, ok, p)
+	var l unstructured.UnstructuredList
+	assert.Nil(t, json.Unmarshal(raw, &l))
+
+	return &l
+}
+
+func docNames(t *testing.T, b *snapshot.Bundle, p string) []string {
+	var nn []string
+	for _, o := range docList(t, b, p).Items {
+		nn = append(nn, o.GetName())
+	}
+
+	return nn
+}
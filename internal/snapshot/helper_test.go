@@ -0,0 +1,134 @@
+package snapshot_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+var testDocs = map[string]string{
+	"/version": `{"major": "1", "minor": "18", "gitVersion": "v1.18.8"}`,
+	"/api":     `{"kind": "APIVersions", "versions": ["v1"]}`,
+	"/apis":    `{"kind": "APIGroupList", "apiVersion": "v1", "groups": []}`,
+	"/api/v1": `{
+  "kind": "APIResourceList",
+  "groupVersion": "v1",
+  "resources": [
+    {"name": "namespaces", "namespaced": false, "kind": "Namespace", "verbs": ["get", "list", "watch"]},
+    {"name": "pods", "namespaced": true, "kind": "Pod", "verbs": ["get", "list", "watch", "delete"]},
+    {"name": "pods/log", "namespaced": true, "kind": "Pod", "verbs": ["get"]},
+    {"name": "secrets", "namespaced": true, "kind": "Secret", "verbs": ["get", "list", "watch"]},
+    {"name": "bindings", "namespaced": true, "kind": "Binding", "verbs": ["create"]}
+  ]
+}`,
+	"/api/v1/namespaces": `{
+  "kind": "NamespaceList",
+  "apiVersion": "v1",
+  "metadata": {"resourceVersion": "10"},
+  "items": [
+    {"metadata": {"name": "default"}},
+    {"metadata": {"name": "ns1"}}
+  ]
+}`,
+	"/api/v1/pods": `{
+  "kind": "PodList",
+  "apiVersion": "v1",
+  "metadata": {"resourceVersion": "10"},
+  "items": [
+    {
+      "metadata": {"name": "fred", "namespace": "default", "labels": {"app": "fred"}},
+      "spec": {"nodeName": "n1", "containers": [{"name": "c1", "image": "fred"}]}
+    },
+    {
+      "metadata": {"name": "blee", "namespace": "ns1", "labels": {"app": "blee"}},
+      "spec": {"nodeName": "n2", "containers": [{"name": "c1", "image": "blee"}]}
+    }
+  ]
+}`,
+	"/api/v1/secrets": `{
+  "kind": "SecretList",
+  "apiVersion": "v1",
+  "metadata": {"resourceVersion": "10"},
+  "items": [
+    {
+      "metadata": {
+        "name": "s1",
+        "namespace": "default",
+        "annotations": {"kubectl.kubernetes.io/last-applied-configuration": "{\"data\":{\"pwd\":\"c2VjcmV0\"}}"}
+      },
+      "data": {"pwd": "c2VjcmV0"}
+    }
+  ]
+}`,
+}
+
+func makeBundle() *snapshot.Bundle {
+	b := snapshot.NewBundle(snapshot.Manifest{
+		Context:   "fred",
+		Cluster:   "zorg",
+		CreatedAt: time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+		Resources: []string{"v1/namespaces", "v1/pods", "v1/secrets"},
+	})
+	for k, v := range testDocs {
+		b.AddDoc(k, []byte(v))
+	}
+	b.AddLog("default", "fred", "c1", []byte("l1\nl2\nl3\n"))
+
+	return b
+}
+
+func makeRestConfig(b *snapshot.Bundle) *restclient.Config {
+	return &restclient.Config{Host: b.Server(), Transport: snapshot.NewTransport(b)}
+}
+
+func makeClient(t *testing.T, b *snapshot.Bundle) kubernetes.Interface {
+	c, err := kubernetes.NewForConfig(makeRestConfig(b))
+	assert.Nil(t, err)
+
+	return c
+}
+
+func makeDynClient(t *testing.T, b *snapshot.Bundle) dynamic.Interface {
+	c, err := dynamic.NewForConfig(makeRestConfig(b))
+	assert.Nil(t, err)
+
+	return c
+}
+
+// makeConfig returns a client config dialing the given bundle.
+func makeConfig(t *testing.T, b *snapshot.Bundle) *client.Config {
+	path := filepath.Join(t.TempDir(), "config")
+	assert.Nil(t, b.WriteKubeConfig(path))
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = &path
+	cfg := client.NewConfig(flags)
+	cfg.SetTransport(snapshot.NewTransport(b))
+
+	return cfg
+}
+
+type testConn struct {
+	client.Connection
+
+	cfg  *client.Config
+	dial kubernetes.Interface
+}
+
+func (c testConn) Config() *client.Config {
+	return c.cfg
+}
+
+func (c testConn) Dial() (kubernetes.Interface, error) {
+	return c.dial, nil
+}
+
+var _ http.RoundTripper = (*snapshot.Transport)(nil)
@@ -0,0 +1,178 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	crdV1Path      = "/apis/apiextensions.k8s.io/v1/customresourcedefinitions"
+	crdV1beta1Path = "/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+	ageColumn      = "Age"
+)
+
+// printerColumn represents a table column extracted from a resource.
+type printerColumn struct {
+	metav1.TableColumnDefinition
+
+	path string
+}
+
+// acceptsTable checks if a request negotiates a server side table.
+func acceptsTable(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Accept"), "as=Table")
+}
+
+// table responds with a list converted to a table.
+func (t *Transport) table(req *http.Request, prefix, res string, list *unstructured.UnstructuredList) (*http.Response, error) {
+	table, err := t.toTable(prefix, res, list)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(table)
+	if err != nil {
+		return nil, err
+	}
+
+	return respond(req, http.StatusOK, jsonContentType, raw), nil
+}
+
+// toTable converts a list into a table as an api server would. Custom
+// resources columns are read from their captured definition printer columns.
+func (t *Transport) toTable(prefix, res string, list *unstructured.UnstructuredList) (*metav1beta1.Table, error) {
+	cols := t.printerColumns(prefix, res)
+	table := metav1beta1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: metav1beta1.SchemeGroupVersion.String()},
+		ListMeta: metav1.ListMeta{ResourceVersion: list.GetResourceVersion()},
+	}
+	table.ColumnDefinitions = append(table.ColumnDefinitions, metav1.TableColumnDefinition{Name: "Name", Type: "string", Format: "name"})
+	for _, c := range cols {
+		table.ColumnDefinitions = append(table.ColumnDefinitions, c.TableColumnDefinition)
+	}
+
+	now := time.Now()
+	for i := range list.Items {
+		o := &list.Items[i]
+		raw, err := o.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		row := metav1beta1.TableRow{
+			Cells:  []interface{}{o.GetName()},
+			Object: runtime.RawExtension{Raw: raw},
+		}
+		for _, c := range cols {
+			row.Cells = append(row.Cells, c.cell(o, now))
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return &table, nil
+}
+
+// printerColumns returns a resource printer columns. Resources without a
+// captured definition only list their age.
+func (t *Transport) printerColumns(prefix, res string) []printerColumn {
+	age := printerColumn{
+		TableColumnDefinition: metav1.TableColumnDefinition{Name: ageColumn, Type: "date"},
+		path:                  ".metadata.creationTimestamp",
+	}
+	tokens := strings.Split(strings.TrimPrefix(prefix, "/"), "/")
+	if len(tokens) != 3 {
+		return []printerColumn{age}
+	}
+	group, version := tokens[1], tokens[2]
+	for _, p := range []string{crdV1Path, crdV1beta1Path} {
+		crds, err := t.list(p)
+		if err != nil || crds == nil {
+			continue
+		}
+		for _, crd := range crds.Items {
+			n, _, _ := unstructured.NestedString(crd.Object, "metadata", "name")
+			if n != res+"."+group {
+				continue
+			}
+			cols := crdColumns(crd.Object, version)
+			for _, c := range cols {
+				if c.Name == ageColumn {
+					return cols
+				}
+			}
+			return append(cols, age)
+		}
+	}
+
+	return []printerColumn{age}
+}
+
+// crdColumns returns a CRD version additional printer columns.
+func crdColumns(crd map[string]interface{}, version string) []printerColumn {
+	cc, _, _ := unstructured.NestedSlice(crd, "spec", "additionalPrinterColumns")
+	vv, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != version {
+			continue
+		}
+		if vc, ok, _ := unstructured.NestedSlice(m, "additionalPrinterColumns"); ok {
+			cc = vc
+		}
+	}
+
+	cols := make([]printerColumn, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var col printerColumn
+		col.Name, _, _ = unstructured.NestedString(m, "name")
+		col.Type, _, _ = unstructured.NestedString(m, "type")
+		col.Format, _, _ = unstructured.NestedString(m, "format")
+		col.Description, _, _ = unstructured.NestedString(m, "description")
+		if p, ok, _ := unstructured.NestedString(m, "jsonPath"); ok {
+			col.path = p
+		} else {
+			col.path, _, _ = unstructured.NestedString(m, "JSONPath")
+		}
+		cols = append(cols, col)
+	}
+
+	return cols
+}
+
+// cell returns a column value for a given resource.
+func (c printerColumn) cell(o *unstructured.Unstructured, now time.Time) interface{} {
+	jp := jsonpath.New(c.Name).AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", c.path)); err != nil {
+		return nil
+	}
+	rr, err := jp.FindResults(o.Object)
+	if err != nil || len(rr) == 0 || len(rr[0]) == 0 {
+		return nil
+	}
+	v := rr[0][0].Interface()
+	if c.Type != "date" {
+		return v
+	}
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return v
+	}
+
+	return duration.HumanDuration(now.Sub(ts))
+}
@@ -0,0 +1,336 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	jsonContentType  = "application/json"
+	textContentType  = "text/plain"
+	protoContentType = "application/com.github.proto-openapi.spec.v2@v1.0+protobuf"
+	ssarPath         = "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews"
+)
+
+var readVerbs = map[string]struct{}{"get": {}, "list": {}, "watch": {}}
+
+// Transport serves a snapshot bundle as a read-only api server.
+type Transport struct {
+	bundle *Bundle
+	lists  map[string]*unstructured.UnstructuredList
+	mx     sync.Mutex
+}
+
+// NewTransport returns a new snapshot transport.
+func NewTransport(b *Bundle) *Transport {
+	return &Transport{
+		bundle: b,
+		lists:  make(map[string]*unstructured.UnstructuredList),
+	}
+}
+
+// RoundTrip serves an api request from the snapshot.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	p := strings.TrimSuffix(req.URL.Path, "/")
+	switch {
+	case req.Method == http.MethodPost && p == ssarPath:
+		return t.review(req)
+	case req.Method != http.MethodGet:
+		return t.status(req, http.StatusForbidden, metav1.StatusReasonForbidden, "snapshot %s is read-only", t.bundle.Manifest.Context), nil
+	case p == "/openapi/v2":
+		if len(t.bundle.OpenAPI()) == 0 {
+			return t.notFound(req), nil
+		}
+		return respond(req, http.StatusOK, protoContentType, t.bundle.OpenAPI()), nil
+	}
+
+	prefix, rest, ok := splitPath(p)
+	if !ok || len(rest) == 0 {
+		raw, ok := t.bundle.Doc(p)
+		if !ok {
+			return t.notFound(req), nil
+		}
+		return respond(req, http.StatusOK, jsonContentType, raw), nil
+	}
+
+	var ns string
+	if rest[0] == "namespaces" && len(rest) > 2 {
+		ns, rest = rest[1], rest[2:]
+	}
+	list, err := t.list(prefix + "/" + rest[0])
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return t.notFound(req), nil
+	}
+
+	switch len(rest) {
+	case 1:
+		if w := req.URL.Query().Get("watch"); w == "true" || w == "1" {
+			return watchResponse(req), nil
+		}
+		return t.filter(req, prefix, rest[0], list, ns)
+	case 2:
+		o, ok := find(list, ns, rest[1])
+		if !ok {
+			return t.status(req, http.StatusNotFound, metav1.StatusReasonNotFound, "%s %q not found", rest[0], rest[1]), nil
+		}
+		if acceptsTable(req) {
+			return t.table(req, prefix, rest[0], &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*o}})
+		}
+		raw, err := o.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return respond(req, http.StatusOK, jsonContentType, raw), nil
+	case 3:
+		if rest[0] == "pods" && rest[2] == "log" {
+			return t.logs(req, ns, rest[1]), nil
+		}
+	}
+
+	return t.notFound(req), nil
+}
+
+func (t *Transport) list(p string) (*unstructured.UnstructuredList, error) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if l, ok := t.lists[p]; ok {
+		return l, nil
+	}
+	raw, ok := t.bundle.Doc(p)
+	if !ok {
+		return nil, nil
+	}
+	var l unstructured.UnstructuredList
+	if err := l.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	t.lists[p] = &l
+
+	return &l, nil
+}
+
+func (t *Transport) filter(req *http.Request, prefix, res string, list *unstructured.UnstructuredList, ns string) (*http.Response, error) {
+	q := req.URL.Query()
+	lsel, err := labels.Parse(q.Get("labelSelector"))
+	if err != nil {
+		return t.status(req, http.StatusBadRequest, metav1.StatusReasonBadRequest, "%v", err), nil
+	}
+	fsel, err := fields.ParseSelector(q.Get("fieldSelector"))
+	if err != nil {
+		return t.status(req, http.StatusBadRequest, metav1.StatusReasonBadRequest, "%v", err), nil
+	}
+
+	l := unstructured.UnstructuredList{Object: list.Object}
+	for _, o := range list.Items {
+		if ns != "" && o.GetNamespace() != ns {
+			continue
+		}
+		if !lsel.Matches(labels.Set(o.GetLabels())) || !fsel.Matches(fieldSet(o, fsel)) {
+			continue
+		}
+		l.Items = append(l.Items, o)
+	}
+	if acceptsTable(req) {
+		return t.table(req, prefix, res, &l)
+	}
+	raw, err := l.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return respond(req, http.StatusOK, jsonContentType, raw), nil
+}
+
+func (t *Transport) logs(req *http.Request, ns, pod string) *http.Response {
+	q := req.URL.Query()
+	if q.Get("previous") == "true" {
+		return t.status(req, http.StatusNotFound, metav1.StatusReasonNotFound, "previous logs are not captured in snapshots")
+	}
+	co := q.Get("container")
+	if co == "" {
+		if cc := t.bundle.PodLogs(ns, pod); len(cc) > 0 {
+			co = cc[0]
+		}
+	}
+	raw, ok := t.bundle.Log(ns, pod, co)
+	if !ok {
+		return t.status(req, http.StatusNotFound, metav1.StatusReasonNotFound, "no logs captured for %s/%s:%s", ns, pod, co)
+	}
+	if n, err := strconv.Atoi(q.Get("tailLines")); err == nil {
+		raw = tail(raw, n)
+	}
+
+	return respond(req, http.StatusOK, textContentType, raw)
+}
+
+// review authorizes read access only.
+func (t *Transport) review(req *http.Request) (*http.Response, error) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	if err := json.NewDecoder(req.Body).Decode(&sar); err != nil {
+		return t.status(req, http.StatusBadRequest, metav1.StatusReasonBadRequest, "%v", err), nil
+	}
+	var verb string
+	if a := sar.Spec.ResourceAttributes; a != nil {
+		verb = a.Verb
+	} else if a := sar.Spec.NonResourceAttributes; a != nil {
+		verb = a.Verb
+	}
+	_, sar.Status.Allowed = readVerbs[verb]
+	if !sar.Status.Allowed {
+		sar.Status.Reason = "snapshots are read-only"
+	}
+	raw, err := json.Marshal(sar)
+	if err != nil {
+		return nil, err
+	}
+
+	return respond(req, http.StatusCreated, jsonContentType, raw), nil
+}
+
+func (t *Transport) notFound(req *http.Request) *http.Response {
+	return t.status(req, http.StatusNotFound, metav1.StatusReasonNotFound, "%s not found in snapshot", req.URL.Path)
+}
+
+func (t *Transport) status(req *http.Request, code int, reason metav1.StatusReason, fmat string, args ...interface{}) *http.Response {
+	s := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  fmt.Sprintf(fmat, args...),
+		Reason:   reason,
+		Code:     int32(code),
+	}
+	raw, _ := json.Marshal(s)
+
+	return respond(req, code, jsonContentType, raw)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitPath splits a resource url path into its group version prefix and the
+// remaining path segments.
+func splitPath(p string) (string, []string, bool) {
+	tokens := strings.Split(strings.TrimPrefix(p, "/"), "/")
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		return "/" + strings.Join(tokens[:2], "/"), tokens[2:], true
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		return "/" + strings.Join(tokens[:3], "/"), tokens[3:], true
+	default:
+		return "", nil, false
+	}
+}
+
+func find(list *unstructured.UnstructuredList, ns, n string) (*unstructured.Unstructured, bool) {
+	for i := range list.Items {
+		if list.Items[i].GetName() == n && list.Items[i].GetNamespace() == ns {
+			return &list.Items[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// fieldSet returns the object fields referenced by a field selector.
+func fieldSet(o unstructured.Unstructured, sel fields.Selector) fields.Set {
+	set := make(fields.Set)
+	for _, r := range sel.Requirements() {
+		v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		if ok && v != nil {
+			set[r.Field] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return set
+}
+
+func tail(raw []byte, n int) []byte {
+	if n < 0 {
+		return raw
+	}
+	lines := bytes.SplitAfter(raw, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if n < len(lines) {
+		lines = lines[len(lines)-n:]
+	}
+
+	return bytes.Join(lines, nil)
+}
+
+func respond(req *http.Request, code int, contentType string, raw []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          ioutil.NopCloser(bytes.NewReader(raw)),
+		ContentLength: int64(len(raw)),
+		Request:       req,
+	}
+}
+
+// watchResponse returns a watch stream which never emits events as snapshots
+// are immutable. The stream closes when the request is cancelled or times out.
+func watchResponse(req *http.Request) *http.Response {
+	body := watchBody{done: make(chan struct{})}
+	go func() {
+		var timeout <-chan time.Time
+		if secs, err := strconv.Atoi(req.URL.Query().Get("timeoutSeconds")); err == nil && secs > 0 {
+			timeout = time.After(time.Duration(secs) * time.Second)
+		}
+		select {
+		case <-req.Context().Done():
+		case <-timeout:
+		case <-body.done:
+			return
+		}
+		body.Close()
+	}()
+
+	resp := respond(req, http.StatusOK, jsonContentType, nil)
+	resp.Body, resp.ContentLength = &body, -1
+
+	return resp
+}
+
+type watchBody struct {
+	done chan struct{}
+	once sync.Once
+}
+
+// Read blocks until the stream is closed.
+func (w *watchBody) Read([]byte) (int, error) {
+	<-w.done
+	return 0, io.EOF
+}
+
+// Close closes the stream.
+func (w *watchBody) Close() error {
+	w.once.Do(func() { close(w.done) })
+	return nil
+}
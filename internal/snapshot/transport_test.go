@@ -0,0 +1,247 @@
+package snapshot_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTransportList(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	uu := map[string]struct {
+		ns   string
+		opts metav1.ListOptions
+		e    []string
+	}{
+		"all":        {e: []string{"fred", "blee"}},
+		"namespaced": {ns: "ns1", e: []string{"blee"}},
+		"labels":     {opts: metav1.ListOptions{LabelSelector: "app=fred"}, e: []string{"fred"}},
+		"fields":     {opts: metav1.ListOptions{FieldSelector: "spec.nodeName=n2"}, e: []string{"blee"}},
+		"none":       {ns: "zorg"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := c.CoreV1().Pods(u.ns).List(context.Background(), u.opts)
+			assert.Nil(t, err)
+			var nn []string
+			for _, po := range pp.Items {
+				nn = append(nn, po.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestTransportTable(t *testing.T) {
+	b := makeBundle()
+	b.AddDoc("/apis/apiextensions.k8s.io/v1/customresourcedefinitions", []byte(`{
+  "kind": "CustomResourceDefinitionList",
+  "apiVersion": "apiextensions.k8s.io/v1",
+  "items": [
+    {
+      "metadata": {"name": "zorgs.fred.io"},
+      "spec": {
+        "group": "fred.io",
+        "versions": [
+          {"name": "v1", "additionalPrinterColumns": [{"name": "Size", "type": "integer", "jsonPath": ".spec.size"}]}
+        ]
+      }
+    }
+  ]
+}`))
+	b.AddDoc("/apis/fred.io/v1/zorgs", []byte(`{
+  "kind": "ZorgList",
+  "apiVersion": "fred.io/v1",
+  "metadata": {"resourceVersion": "10"},
+  "items": [
+    {"apiVersion": "fred.io/v1", "kind": "Zorg", "metadata": {"name": "z1", "namespace": "default", "creationTimestamp": "2020-10-01T12:00:00Z"}, "spec": {"size": 3}},
+    {"apiVersion": "fred.io/v1", "kind": "Zorg", "metadata": {"name": "z2", "namespace": "ns1"}}
+  ]
+}`))
+
+	uu := map[string]struct {
+		path  string
+		cols  []string
+		cells [][]interface{}
+	}{
+		"crd": {
+			path:  "/apis/fred.io/v1/zorgs",
+			cols:  []string{"Name", "Size", "Age"},
+			cells: [][]interface{}{{"z1", float64(3)}, {"z2", nil, nil}},
+		},
+		"namespaced": {
+			path:  "/apis/fred.io/v1/namespaces/ns1/zorgs",
+			cols:  []string{"Name", "Size", "Age"},
+			cells: [][]interface{}{{"z2", nil, nil}},
+		},
+		"get": {
+			path:  "/apis/fred.io/v1/namespaces/ns1/zorgs/z2",
+			cols:  []string{"Name", "Size", "Age"},
+			cells: [][]interface{}{{"z2", nil, nil}},
+		},
+		"builtin": {
+			path:  "/api/v1/namespaces/default/pods",
+			cols:  []string{"Name", "Age"},
+			cells: [][]interface{}{{"fred", nil}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, b.Server()+u.path, nil)
+			req.Header.Set("Accept", "application/json;as=Table;v=v1beta1;g=meta.k8s.io, application/json")
+			resp, err := snapshot.NewTransport(b).RoundTrip(req)
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var table metav1beta1.Table
+			assert.Nil(t, json.NewDecoder(resp.Body).Decode(&table))
+			assert.Equal(t, "Table", table.Kind)
+			cols := make([]string, 0, len(table.ColumnDefinitions))
+			for _, c := range table.ColumnDefinitions {
+				cols = append(cols, c.Name)
+			}
+			assert.Equal(t, u.cols, cols)
+			assert.Equal(t, len(u.cells), len(table.Rows))
+			for i, cc := range u.cells {
+				assert.Equal(t, cc, table.Rows[i].Cells[:len(cc)])
+				assert.NotEmpty(t, table.Rows[i].Object.Raw)
+			}
+		})
+	}
+}
+
+func TestTransportGet(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	po, err := c.CoreV1().Pods("default").Get(context.Background(), "fred", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "n1", po.Spec.NodeName)
+
+	ns, err := c.CoreV1().Namespaces().Get(context.Background(), "ns1", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1", ns.Name)
+
+	_, err = c.CoreV1().Pods("ns1").Get(context.Background(), "fred", metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+
+	_, err = c.AppsV1().Deployments("default").List(context.Background(), metav1.ListOptions{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestTransportDiscovery(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	info, err := c.Discovery().ServerVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.18.8", info.GitVersion)
+
+	rr, err := c.Discovery().ServerResourcesForGroupVersion("v1")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(rr.APIResources))
+}
+
+func TestTransportLogs(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	uu := map[string]struct {
+		opts v1.PodLogOptions
+		e    string
+		err  bool
+	}{
+		"all":      {opts: v1.PodLogOptions{Container: "c1"}, e: "l1\nl2\nl3\n"},
+		"default":  {e: "l1\nl2\nl3\n"},
+		"tail":     {opts: v1.PodLogOptions{Container: "c1", TailLines: int64Ptr(2)}, e: "l2\nl3\n"},
+		"previous": {opts: v1.PodLogOptions{Container: "c1", Previous: true}, err: true},
+		"missing":  {opts: v1.PodLogOptions{Container: "c2"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := c.CoreV1().Pods("default").GetLogs("fred", &u.opts).DoRaw(context.Background())
+			if u.err {
+				assert.True(t, errors.IsNotFound(err))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
+
+func TestTransportReadOnly(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	err := c.CoreV1().Pods("default").Delete(context.Background(), "fred", metav1.DeleteOptions{})
+	assert.True(t, errors.IsForbidden(err))
+
+	po, err := c.CoreV1().Pods("default").Get(context.Background(), "fred", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", po.Name)
+}
+
+func TestTransportAccessReview(t *testing.T) {
+	c := makeClient(t, makeBundle())
+
+	uu := map[string]struct {
+		verb string
+		e    bool
+	}{
+		"list":   {verb: "list", e: true},
+		"watch":  {verb: "watch", e: true},
+		"delete": {verb: "delete"},
+		"patch":  {verb: "patch"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sar := authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: u.verb, Resource: "pods"},
+				},
+			}
+			res, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), &sar, metav1.CreateOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, res.Status.Allowed)
+		})
+	}
+}
+
+func TestTransportWatch(t *testing.T) {
+	c := makeDynClient(t, makeBundle())
+
+	w, err := c.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Watch(context.Background(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	select {
+	case evt := <-w.ResultChan():
+		t.Fatalf("unexpected watch event %v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+	w.Stop()
+	_, ok := <-w.ResultChan()
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
@@ -1,6 +1,7 @@
 package tchart
 
 import (
+	"fmt"
 	"image"
 	"sync"
 
@@ -22,6 +23,8 @@ type Component struct {
 	seriesColors               []tcell.Color
 	dimmed                     tcell.Style
 	id, legend                 string
+	seriesLabels               [2]string
+	static                     bool
 	blur                       func(tcell.Key)
 	mx                         sync.RWMutex
 }
@@ -31,6 +34,7 @@ func NewComponent(id string) *Component {
 	return &Component{
 		Box:          tview.NewBox(),
 		id:           id,
+		seriesLabels: [2]string{"ok", "fault"},
 		noColor:      tcell.ColorDefault,
 		seriesColors: []tcell.Color{tview.Styles.PrimaryTextColor, tview.Styles.FocusColor},
 		dimmed:       tcell.StyleDefault.Background(tview.Styles.PrimitiveBackgroundColor).Foreground(tcell.ColorGray).Dim(true),
@@ -61,6 +65,28 @@ func (c *Component) SetLegend(l string) {
 	c.legend = l
 }
 
+// SetStatic turns animations off. Static components print out their latest
+// values as plain text.
+func (c *Component) SetStatic(b bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.static = b
+}
+
+// IsStatic returns true if animations are turned off.
+func (c *Component) IsStatic() bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.static
+}
+
+// SetSeriesLabels sets the series labels used by static components.
+func (c *Component) SetSeriesLabels(s1, s2 string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.seriesLabels = [2]string{s1, s2}
+}
+
 // InputHandler returns the handler for this primitive.
 func (c *Component) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 	return c.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
@@ -130,3 +156,45 @@ func (c *Component) asRect() image.Rectangle {
 		Max: image.Point{X: x + width, Y: y + height},
 	}
 }
+
+// drawStatic prints out a metric and its trends as plain text.
+func (c *Component) drawStatic(sc tcell.Screen, m Metric, d1, d2 delta) {
+	rect := c.asRect()
+	if rect.Dx() <= 0 || rect.Dy() <= 0 {
+		return
+	}
+
+	lines := []string{
+		fmt.Sprintf("%s %d%s", c.seriesLabels[0], m.S1, trend(d1)),
+		fmt.Sprintf("%s %d%s", c.seriesLabels[1], m.S2, trend(d2)),
+	}
+	if c.legend != "" {
+		legend := c.legend
+		if c.HasFocus() {
+			legend = fmt.Sprintf("[%s:%s:]", c.focusFgColor, c.focusBgColor) + c.legend + "[::]"
+		}
+		lines = append(lines, legend)
+	}
+	y := rect.Min.Y + (rect.Dy()-len(lines))/2
+	if y < rect.Min.Y {
+		y = rect.Min.Y
+	}
+	for _, l := range lines {
+		if y >= rect.Max.Y {
+			break
+		}
+		tview.Print(sc, l, rect.Min.X, y, rect.Dx(), tview.AlignCenter, tview.Styles.PrimaryTextColor)
+		y++
+	}
+}
+
+func trend(d delta) string {
+	switch d {
+	case DeltaMore:
+		return " rising"
+	case DeltaLess:
+		return " falling"
+	default:
+		return ""
+	}
+}
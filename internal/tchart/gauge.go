@@ -73,6 +73,10 @@ func (g *Gauge) Draw(sc tcell.Screen) {
 	g.mx.RLock()
 	defer g.mx.RUnlock()
 
+	if g.static {
+		g.drawStatic(sc, g.data, g.deltaOk, g.deltaS2)
+		return
+	}
 	rect := g.asRect()
 	mid := image.Point{X: rect.Min.X + rect.Dx()/2, Y: rect.Min.Y + rect.Dy()/2 - 1}
 	style := tcell.StyleDefault.Background(g.bgColor)
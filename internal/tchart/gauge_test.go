@@ -1,8 +1,10 @@
 package tchart_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/tchart"
 	"github.com/stretchr/testify/assert"
 )
@@ -54,3 +56,40 @@ func TestMetricsMax(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticDraw(t *testing.T) {
+	sc := tcell.NewSimulationScreen("UTF-8")
+	assert.Nil(t, sc.Init())
+	sc.SetSize(30, 6)
+
+	g := tchart.NewGauge("fred")
+	g.SetStatic(true)
+	g.SetSeriesLabels("used", "free")
+	g.SetLegend("Fred")
+	g.SetRect(0, 0, 30, 6)
+	g.Add(tchart.Metric{S1: 10, S2: 2})
+	g.Add(tchart.Metric{S1: 12, S2: 1})
+	g.Draw(sc)
+	sc.Show()
+
+	assert.True(t, g.IsStatic())
+	assert.Equal(t, []string{"used 12 rising", "free 1 falling", "Fred"}, screenLines(sc))
+}
+
+func screenLines(sc tcell.SimulationScreen) []string {
+	cc, w, h := sc.GetContents()
+	ll := make([]string, 0, h)
+	for y := 0; y < h; y++ {
+		var b strings.Builder
+		for x := 0; x < w; x++ {
+			if r := cc[y*w+x].Runes; len(r) > 0 {
+				b.WriteRune(r[0])
+			}
+		}
+		if l := strings.TrimSpace(b.String()); l != "" {
+			ll = append(ll, l)
+		}
+	}
+
+	return ll
+}
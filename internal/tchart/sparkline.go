@@ -80,6 +80,14 @@ func (s *SparkLine) Draw(screen tcell.Screen) {
 	if len(s.data) == 0 {
 		return
 	}
+	if s.static {
+		last, prev := s.data[len(s.data)-1], s.data[len(s.data)-1]
+		if len(s.data) > 1 {
+			prev = s.data[len(s.data)-2]
+		}
+		s.drawStatic(screen, last, computeDelta(prev.S1, last.S1), computeDelta(prev.S2, last.S2))
+		return
+	}
 
 	pad := 0
 	if s.legend != "" {
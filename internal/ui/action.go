@@ -2,6 +2,7 @@ package ui
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/open-infra/osc/internal/model"
 	"github.com/gdamore/tcell/v2"
@@ -62,6 +63,30 @@ func (a KeyActions) Delete(kk ...tcell.Key) {
 	}
 }
 
+// Remap rebinds actions to new keys given their descriptions. Actions
+// displaced by a remapped binding are removed and returned.
+func (a KeyActions) Remap(kk map[string]tcell.Key) []KeyAction {
+	moves := make(KeyActions, len(kk))
+	for k, v := range a {
+		nk, ok := kk[strings.ToLower(v.Description)]
+		if !ok || nk == k {
+			continue
+		}
+		moves[nk] = v
+		delete(a, k)
+	}
+
+	var displaced []KeyAction
+	for k, v := range moves {
+		if old, ok := a[k]; ok && !strings.EqualFold(old.Description, v.Description) {
+			displaced = append(displaced, old)
+		}
+		a[k] = v
+	}
+
+	return displaced
+}
+
 // Hints returns a collection of hints.
 func (a KeyActions) Hints() model.MenuHints {
 	kk := make([]int, 0, len(a))
@@ -3,6 +3,7 @@ package ui_test
 import (
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/model"
 	"github.com/open-infra/osc/internal/ui"
 	"github.com/stretchr/testify/assert"
@@ -20,3 +21,22 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestKeyActionsRemap(t *testing.T) {
+	kk := ui.KeyActions{
+		ui.KeyF:      ui.NewKeyAction("fred", nil, true),
+		ui.KeyB:      ui.NewKeyAction("blee", nil, true),
+		ui.KeyShiftZ: ui.NewKeyAction("zorg", nil, true),
+	}
+
+	displaced := kk.Remap(map[string]tcell.Key{
+		"fred": ui.KeyShiftF,
+		"blee": ui.KeyShiftZ,
+		"duh":  ui.KeyD,
+	})
+
+	assert.Equal(t, 2, len(kk))
+	assert.Equal(t, "fred", kk[ui.KeyShiftF].Description)
+	assert.Equal(t, "blee", kk[ui.KeyShiftZ].Description)
+	assert.Equal(t, []ui.KeyAction{ui.NewKeyAction("zorg", nil, true)}, displaced)
+}
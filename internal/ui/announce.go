@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Announcer writes accessibility announcements as plain text lines so they
+// can be picked up by screen readers.
+type Announcer struct {
+	w    io.Writer
+	last string
+	mx   sync.Mutex
+}
+
+// NewAnnouncer returns a new announcer.
+func NewAnnouncer(w io.Writer) *Announcer {
+	return &Announcer{w: w}
+}
+
+// Announce writes out a plain text announcement. Consecutive duplicated
+// announcements are skipped.
+func (a *Announcer) Announce(msg string) error {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return nil
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	if msg == a.last {
+		return nil
+	}
+	a.last = msg
+	_, err := fmt.Fprintln(a.w, msg)
+
+	return err
+}
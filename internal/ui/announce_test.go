@@ -0,0 +1,22 @@
+package ui_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnouncer(t *testing.T) {
+	var buff bytes.Buffer
+	a := ui.NewAnnouncer(&buff)
+
+	assert.Nil(t, a.Announce("Viewing pods"))
+	assert.Nil(t, a.Announce(" Viewing pods "))
+	assert.Nil(t, a.Announce(""))
+	assert.Nil(t, a.Announce("Error: boom"))
+	assert.Nil(t, a.Announce("Viewing pods"))
+
+	assert.Equal(t, "Viewing pods\nError: boom\nViewing pods\n", buff.String())
+}
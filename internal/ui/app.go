@@ -21,9 +21,10 @@ type App struct {
 	flash   *model.Flash
 	actions KeyActions
 	views   map[string]tview.Primitive
-	cmdBuff *model.FishBuff
-	running bool
-	mx      sync.RWMutex
+	cmdBuff   *model.FishBuff
+	announcer *Announcer
+	running   bool
+	mx        sync.RWMutex
 }
 
 // NewApp returns a new app.
@@ -41,7 +42,7 @@ func NewApp(cfg *config.Config, context string) *App {
 	a.views = map[string]tview.Primitive{
 		"menu":   NewMenu(a.Styles),
 		"logo":   NewLogo(a.Styles),
-		"prompt": NewPrompt(a.Config.Osc.NoIcons, a.Styles),
+		"prompt": NewPrompt(a.Config.Osc.IsNoIcons(), a.Styles),
 		"crumbs": NewCrumbs(a.Styles),
 	}
 
@@ -56,6 +57,29 @@ func (a *App) Init() {
 	a.Styles.AddListener(a)
 
 	a.SetRoot(a.Main, true).EnableMouse(a.Config.Osc.EnableMouse)
+	if a.Config.Osc.IsAccessible() {
+		f, err := os.OpenFile(config.OscAnnounceFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Error().Err(err).Msgf("Unable to open announcements file %q", config.OscAnnounceFile)
+			return
+		}
+		a.SetAnnouncer(NewAnnouncer(f))
+	}
+}
+
+// SetAnnouncer sets the accessibility announcer.
+func (a *App) SetAnnouncer(an *Announcer) {
+	a.announcer = an
+}
+
+// Announce announces a message in accessibility mode.
+func (a *App) Announce(msg string) {
+	if a.announcer == nil {
+		return
+	}
+	if err := a.announcer.Announce(msg); err != nil {
+		log.Error().Err(err).Msg("Announce failed")
+	}
 }
 
 // QueueUpdate queues up a ui action.
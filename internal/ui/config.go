@@ -111,7 +111,20 @@ func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error
 	}()
 
 	log.Debug().Msgf("SkinWatcher watching `%s", c.skinFile)
-	return w.Add(c.skinFile)
+	if err := w.Add(c.skinFile); err != nil {
+		return err
+	}
+	for _, f := range c.Styles.Files() {
+		if f == c.skinFile {
+			continue
+		}
+		log.Debug().Msgf("SkinWatcher watching base skin `%s", f)
+		if err := w.Add(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // BenchConfig location of the benchmarks configuration file.
@@ -147,7 +160,11 @@ func (c *Configurator) RefreshStyles(context string) {
 func (c *Configurator) updateStyles(f string) {
 	c.skinFile = f
 	if !c.HasSkin() {
-		c.Styles.DefaultSkin()
+		if c.Config != nil && c.Config.Osc.IsAccessible() {
+			c.Styles.HighContrastSkin()
+		} else {
+			c.Styles.DefaultSkin()
+		}
 	}
 	c.Styles.Update()
 
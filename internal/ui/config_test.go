@@ -27,3 +27,15 @@ func TestConfiguratorRefreshStyle(t *testing.T) {
 	assert.Equal(t, tcell.ColorGhostWhite, render.StdColor)
 	assert.Equal(t, tcell.ColorWhiteSmoke, render.ErrColor)
 }
+
+func TestConfiguratorHighContrastStyle(t *testing.T) {
+	config.K9sStylesFile = filepath.Join("..", "config", "testdata", "blee.yml")
+
+	cfg := ui.Configurator{Config: config.NewConfig(nil)}
+	cfg.Config.Osc.Accessible = true
+	cfg.RefreshStyles("")
+
+	assert.False(t, cfg.HasSkin())
+	assert.Equal(t, tcell.ColorFuchsia, render.ErrColor)
+	assert.Equal(t, "yellow", cfg.Styles.Table().CursorBgColor.String())
+}
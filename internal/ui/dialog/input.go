@@ -0,0 +1,57 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const inputKey = "input"
+
+type inputOkFunc func(string)
+
+// ShowInput pops a single field input dialog.
+func ShowInput(styles config.Dialog, pages *ui.Pages, title, msg, label, value string, ok inputOkFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddInputField(label, value, 0, nil, func(v string) {
+		value = v
+	})
+	f.AddButton("Cancel", func() {
+		dismissInput(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissInput(pages)
+		ok(strings.TrimSpace(value))
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissInput(pages)
+		cancel()
+	})
+	pages.AddPage(inputKey, modal, false, false)
+	pages.ShowPage(inputKey)
+}
+
+func dismissInput(pages *ui.Pages) {
+	pages.RemovePage(inputKey)
+}
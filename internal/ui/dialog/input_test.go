@@ -0,0 +1,22 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowInput(config.Dialog{}, p, "Rename", "Yo", "Name:", "fred", func(string) {}, func() {})
+
+	d := p.GetPrimitive(inputKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissInput(p)
+	assert.Nil(t, p.GetPrimitive(inputKey))
+}
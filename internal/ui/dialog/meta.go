@@ -0,0 +1,102 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const metaKey = "meta"
+
+// MetaSpec tracks a resource editable metadata. Labels and annotations rows
+// use the `key=value` format, taints rows use the `key[=value]:Effect` format.
+type MetaSpec struct {
+	Labels      []string
+	Annotations []string
+	Taints      []string
+	WithTaints  bool
+}
+
+type metaOkFunc func(MetaSpec)
+
+// ShowMeta pops a labels, annotations and taints editor dialog. Clearing a
+// row removes the entry, the trailing blank row adds a new one.
+func ShowMeta(styles config.Dialog, pages *ui.Pages, msg string, spec MetaSpec, ok metaOkFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	labels := addMetaRows(f, "Label:", spec.Labels)
+	annotations := addMetaRows(f, "Annotation:", spec.Annotations)
+	var taints []string
+	if spec.WithTaints {
+		taints = addMetaRows(f, "Taint:", spec.Taints)
+	}
+
+	f.AddButton("Cancel", func() {
+		dismissMeta(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissMeta(pages)
+		ok(MetaSpec{
+			Labels:      compactRows(labels),
+			Annotations: compactRows(annotations),
+			Taints:      compactRows(taints),
+			WithTaints:  spec.WithTaints,
+		})
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	modal := tview.NewModalForm("<Edit Metadata>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissMeta(pages)
+		cancel()
+	})
+	pages.AddPage(metaKey, modal, false, false)
+	pages.ShowPage(metaKey)
+}
+
+func dismissMeta(pages *ui.Pages) {
+	pages.RemovePage(metaKey)
+}
+
+// addMetaRows adds an input field per row plus a blank one and returns the
+// backing rows updated as fields change.
+func addMetaRows(f *tview.Form, label string, rr []string) []string {
+	rows := make([]string, len(rr)+1)
+	copy(rows, rr)
+	for i := range rows {
+		i := i
+		f.AddInputField(label, rows[i], 0, nil, func(v string) {
+			rows[i] = v
+		})
+	}
+
+	return rows
+}
+
+func compactRows(rr []string) []string {
+	cc := make([]string, 0, len(rr))
+	for _, r := range rr {
+		if r = strings.TrimSpace(r); r != "" {
+			cc = append(cc, r)
+		}
+	}
+
+	return cc
+}
@@ -0,0 +1,40 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	spec := MetaSpec{
+		Labels:      []string{"app=fred"},
+		Annotations: []string{"note=blee"},
+		Taints:      []string{"a=b:NoSchedule"},
+		WithTaints:  true,
+	}
+	ShowMeta(config.Dialog{}, p, "Yo", spec, func(MetaSpec) {}, func() {})
+
+	d := p.GetPrimitive(metaKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissMeta(p)
+	assert.Nil(t, p.GetPrimitive(metaKey))
+}
+
+func TestAddMetaRows(t *testing.T) {
+	f := tview.NewForm()
+	rows := addMetaRows(f, "Label:", []string{"app=fred", "tier=web"})
+
+	assert.Equal(t, 3, f.GetFormItemCount())
+	assert.Equal(t, []string{"app=fred", "tier=web", ""}, rows)
+
+	f.GetFormItem(0).(*tview.InputField).SetText("")
+	f.GetFormItem(2).(*tview.InputField).SetText(" zone=east ")
+	assert.Equal(t, []string{"tier=web", "zone=east"}, compactRows(rows))
+}
@@ -0,0 +1,74 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const (
+	resultsKey      = "results"
+	maxResultsLines = 25
+)
+
+// Result tracks an operation outcome for a given item.
+type Result struct {
+	Item string
+	Err  error
+}
+
+// ShowResults pops a dialog listing operations outcomes.
+func ShowResults(styles config.Dialog, pages *ui.Pages, title string, rr []Result) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton("OK", func() {
+		dismissResults(pages)
+	})
+	if b := f.GetButton(0); b != nil {
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(resultsText(rr))
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissResults(pages)
+	})
+	pages.AddPage(resultsKey, modal, false, false)
+	pages.ShowPage(resultsKey)
+}
+
+func dismissResults(pages *ui.Pages) {
+	pages.RemovePage(resultsKey)
+}
+
+// resultsText lists failures first followed by successes.
+func resultsText(rr []Result) string {
+	var failed, ok []string
+	for _, r := range rr {
+		if r.Err != nil {
+			failed = append(failed, fmt.Sprintf("[red::]FAIL[-::] %s: %s", r.Item, r.Err))
+			continue
+		}
+		ok = append(ok, fmt.Sprintf("[green::]OK[-::] %s", r.Item))
+	}
+
+	ll := append(failed, ok...)
+	if len(ll) > maxResultsLines {
+		more := len(ll) - maxResultsLines
+		ll = append(ll[:maxResultsLines], fmt.Sprintf("... and %d more", more))
+	}
+	summary := fmt.Sprintf("%d succeeded, %d failed", len(ok), len(failed))
+
+	return summary + "\n\n" + strings.Join(ll, "\n")
+}
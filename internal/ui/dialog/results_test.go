@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultsDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowResults(config.Dialog{}, p, "Blee", []Result{{Item: "default/fred"}})
+
+	d := p.GetPrimitive(resultsKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissResults(p)
+	assert.Nil(t, p.GetPrimitive(resultsKey))
+}
+
+func TestResultsText(t *testing.T) {
+	rr := []Result{
+		{Item: "default/fred"},
+		{Item: "default/blee", Err: errors.New("boom")},
+	}
+
+	assert.Equal(t, "1 succeeded, 1 failed\n\n[red::]FAIL[-::] default/blee: boom\n[green::]OK[-::] default/fred", resultsText(rr))
+}
+
+func TestResultsTextTruncate(t *testing.T) {
+	rr := make([]Result, 0, 30)
+	for i := 0; i < 30; i++ {
+		rr = append(rr, Result{Item: fmt.Sprintf("default/fred-%d", i)})
+	}
+
+	ll := strings.Split(resultsText(rr), "\n")
+	assert.Equal(t, maxResultsLines+3, len(ll))
+	assert.Equal(t, "... and 5 more", ll[len(ll)-1])
+}
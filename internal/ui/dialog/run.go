@@ -0,0 +1,76 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const runKey = "run"
+
+// RunSpec tracks a job run container overrides. Env rows use the
+// `KEY=VALUE` format and args are space separated.
+type RunSpec struct {
+	Container string
+	Env       []string
+	Args      string
+}
+
+type runOkFunc func(RunSpec)
+
+// ShowRun pops a job run dialog to override a container env vars and args
+// before the job gets created. Blank args leave the template args as is.
+func ShowRun(styles config.Dialog, pages *ui.Pages, msg string, spec RunSpec, ok runOkFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	f.AddInputField("Container:", spec.Container, 0, nil, func(v string) {
+		spec.Container = v
+	})
+	env := addMetaRows(f, "Env:", spec.Env)
+	f.AddInputField("Args:", spec.Args, 0, nil, func(v string) {
+		spec.Args = v
+	})
+
+	f.AddButton("Cancel", func() {
+		dismissRun(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissRun(pages)
+		ok(RunSpec{
+			Container: strings.TrimSpace(spec.Container),
+			Env:       compactRows(env),
+			Args:      strings.TrimSpace(spec.Args),
+		})
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	modal := tview.NewModalForm("<Trigger>", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissRun(pages)
+		cancel()
+	})
+	pages.AddPage(runKey, modal, false, false)
+	pages.ShowPage(runKey)
+}
+
+func dismissRun(pages *ui.Pages) {
+	pages.RemovePage(runKey)
+}
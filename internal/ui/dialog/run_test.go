@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	spec := RunSpec{Container: "c1", Env: []string{"A=1"}, Args: "--dry-run"}
+	ShowRun(config.Dialog{}, p, "Yo", spec, func(RunSpec) {}, func() {})
+
+	d := p.GetPrimitive(runKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissRun(p)
+	assert.Nil(t, p.GetPrimitive(runKey))
+}
@@ -70,6 +70,13 @@ func (f *Flash) SetMessage(m model.LevelMessage) {
 			f.Clear()
 			return
 		}
+		if f.app.Config.Osc.IsAccessible() {
+			msg := flashLabel(m.Level) + ": " + m.Text
+			f.SetTextColor(f.app.Styles.FgColor())
+			f.SetText(msg)
+			f.app.Announce(msg)
+			return
+		}
 		f.SetTextColor(flashColor(m.Level))
 		f.SetText(f.flashEmoji(m.Level) + " " + m.Text)
 	}
@@ -82,7 +89,7 @@ func (f *Flash) SetMessage(m model.LevelMessage) {
 }
 
 func (f *Flash) flashEmoji(l model.FlashLevel) string {
-	if f.app.Config.Osc.NoIcons {
+	if f.app.Config.Osc.IsNoIcons() {
 		return ""
 	}
 	switch l {
@@ -97,6 +104,17 @@ func (f *Flash) flashEmoji(l model.FlashLevel) string {
 
 // Helpers...
 
+func flashLabel(l model.FlashLevel) string {
+	switch l {
+	case model.FlashWarn:
+		return "Warning"
+	case model.FlashErr:
+		return "Error"
+	default:
+		return "Info"
+	}
+}
+
 func flashColor(l model.FlashLevel) tcell.Color {
 	switch l {
 	case model.FlashWarn:
@@ -1,6 +1,7 @@
 package ui_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -38,3 +39,18 @@ func TestFlash(t *testing.T) {
 		})
 	}
 }
+
+func TestFlashAccessible(t *testing.T) {
+	var buff bytes.Buffer
+	cfg := config.NewConfig(nil)
+	cfg.Osc.Accessible = true
+	a := ui.NewApp(cfg, "test")
+	a.SetAnnouncer(ui.NewAnnouncer(&buff))
+	f := ui.NewFlash(a)
+	f.SetTestMode(true)
+
+	f.SetMessage(model.LevelMessage{Level: model.FlashErr, Text: "hello"})
+	assert.Equal(t, "Error: hello\n", f.GetText(false))
+	f.SetMessage(model.LevelMessage{Level: model.FlashWarn, Text: "bye"})
+	assert.Equal(t, "Error: hello\nWarning: bye\n", buff.String())
+}
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-infra/osc/internal/render"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// GroupOpenSign signals an expanded group.
+	GroupOpenSign = "▼"
+	// GroupClosedSign signals a collapsed group.
+	GroupClosedSign = "▶"
+)
+
+// GroupMode represents a table rows grouping mode.
+type GroupMode int
+
+const (
+	// GroupNone disables grouping.
+	GroupNone GroupMode = iota
+	// GroupExpanded shows group headers and their rows.
+	GroupExpanded
+	// GroupCollapsed only shows group headers.
+	GroupCollapsed
+)
+
+// Next returns the next grouping mode.
+func (g GroupMode) Next() GroupMode {
+	return (g + 1) % 3
+}
+
+// RowGroup tracks rows sharing the same column value.
+type RowGroup struct {
+	Key       string
+	RowEvents render.RowEvents
+}
+
+// GroupRows partitions rows by a column value. Groups are sorted by key and
+// rows retain their original order.
+func GroupRows(rr render.RowEvents, col int) []RowGroup {
+	index := make(map[string]int)
+	var gg []RowGroup
+	for _, re := range rr {
+		if col < 0 || col >= len(re.Row.Fields) {
+			continue
+		}
+		k := strings.TrimSpace(re.Row.Fields[col])
+		i, ok := index[k]
+		if !ok {
+			i = len(gg)
+			index[k] = i
+			gg = append(gg, RowGroup{Key: k})
+		}
+		gg[i].RowEvents = append(gg[i].RowEvents, re)
+	}
+	sort.SliceStable(gg, func(i, j int) bool {
+		return gg[i].Key < gg[j].Key
+	})
+
+	return gg
+}
+
+// Aggregate returns the group totals. Numerical and quantity columns are
+// summed up, other columns are left blank.
+func (g RowGroup) Aggregate(h render.Header) render.Fields {
+	ff := make(render.Fields, len(h))
+	for c := range h {
+		if h.IsAgeCol(c) || strings.HasPrefix(h[c].Name, "%") {
+			continue
+		}
+		if s, ok := sumCol(g.RowEvents, c); ok {
+			ff[c] = s
+		}
+	}
+
+	return ff
+}
+
+// Title returns the group header title.
+func (g RowGroup) Title(mode GroupMode) string {
+	sign, key := GroupOpenSign, g.Key
+	if mode == GroupCollapsed {
+		sign = GroupClosedSign
+	}
+	if key == "" {
+		key = render.MissingValue
+	}
+
+	return fmt.Sprintf("%s %s (%d)", sign, key, len(g.RowEvents))
+}
+
+func sumCol(rr render.RowEvents, col int) (string, bool) {
+	var (
+		total         resource.Quantity
+		ints, matched bool
+		n             int
+	)
+	ints = true
+	for _, re := range rr {
+		if col >= len(re.Row.Fields) {
+			continue
+		}
+		v := strings.TrimSpace(re.Row.Fields[col])
+		if isBlankValue(v) {
+			continue
+		}
+		matched = true
+		if i, ok := numerical(v); ok {
+			n += i
+			continue
+		}
+		if _, err := time.ParseDuration(v); err == nil {
+			return "", false
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return "", false
+		}
+		ints = false
+		total.Add(q)
+	}
+	if !matched {
+		return "", false
+	}
+	if ints {
+		return strconv.Itoa(n), true
+	}
+	total.Add(*resource.NewQuantity(int64(n), resource.DecimalSI))
+
+	return total.String(), true
+}
+
+func isBlankValue(v string) bool {
+	switch v {
+	case "", render.NAValue, render.MissingValue, render.UnknownValue:
+		return true
+	default:
+		return false
+	}
+}
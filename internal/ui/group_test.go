@@ -0,0 +1,84 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupRows(t *testing.T) {
+	gg := ui.GroupRows(makeGroupRows(), 0)
+
+	assert.Equal(t, 2, len(gg))
+	assert.Equal(t, "default", gg[0].Key)
+	assert.Equal(t, "r1", gg[0].RowEvents[0].Row.ID)
+	assert.Equal(t, "r3", gg[0].RowEvents[1].Row.ID)
+	assert.Equal(t, "kube-system", gg[1].Key)
+	assert.Equal(t, "▼ default (2)", gg[0].Title(ui.GroupExpanded))
+	assert.Equal(t, "▶ kube-system (1)", gg[1].Title(ui.GroupCollapsed))
+}
+
+func TestRowGroupAggregate(t *testing.T) {
+	h := render.Header{
+		render.HeaderColumn{Name: "NAMESPACE"},
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "RESTARTS"},
+		render.HeaderColumn{Name: "CPU"},
+		render.HeaderColumn{Name: "MEM"},
+		render.HeaderColumn{Name: "%CPU/R"},
+		render.HeaderColumn{Name: "LAST"},
+		render.HeaderColumn{Name: "AGE"},
+	}
+	gg := ui.GroupRows(makeGroupRows(), 0)
+
+	assert.Equal(t, render.Fields{"", "", "3", "150", "1152Mi", "", "", ""}, gg[0].Aggregate(h))
+	assert.Equal(t, render.Fields{"", "", "0", "", "64Mi", "", "", ""}, gg[1].Aggregate(h))
+}
+
+func TestGroupModeNext(t *testing.T) {
+	assert.Equal(t, ui.GroupExpanded, ui.GroupNone.Next())
+	assert.Equal(t, ui.GroupCollapsed, ui.GroupExpanded.Next())
+	assert.Equal(t, ui.GroupNone, ui.GroupCollapsed.Next())
+}
+
+func TestTableToggleGroup(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.SetSortCol("A", true)
+
+	v.ToggleGroup()
+	col, mode := v.GroupBy()
+	assert.Equal(t, "A", col)
+	assert.Equal(t, ui.GroupExpanded, mode)
+	v.Update(m.Peek(), false)
+	assert.Equal(t, 4, v.GetRowCount())
+	assert.Equal(t, "▼ blee (2)", v.GetCell(1, 0).Text)
+	assert.Nil(t, v.GetCell(1, 0).GetReference())
+
+	v.ToggleGroup()
+	v.Update(m.Peek(), false)
+	assert.Equal(t, 2, v.GetRowCount())
+
+	v.ToggleGroup()
+	v.Update(m.Peek(), false)
+	col, mode = v.GroupBy()
+	assert.Equal(t, "", col)
+	assert.Equal(t, ui.GroupNone, mode)
+	assert.Equal(t, 3, v.GetRowCount())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeGroupRows() render.RowEvents {
+	return render.RowEvents{
+		{Row: render.Row{ID: "r1", Fields: render.Fields{"default", "fred", "1", "100", "128Mi", "10", "5m", "2h"}}},
+		{Row: render.Row{ID: "r2", Fields: render.Fields{"kube-system", "blee", "0", "n/a", "64Mi", "20", "10m", "1h"}}},
+		{Row: render.Row{ID: "r3", Fields: render.Fields{"default", "duh", "2", "50", "1Gi", "30", "1m", "3h"}}},
+	}
+}
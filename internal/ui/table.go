@@ -38,12 +38,16 @@ type Table struct {
 	actions     KeyActions
 	cmdBuff     *model.FishBuff
 	styles      *config.Styles
+	tableStyles config.Table
 	viewSetting *config.ViewSetting
 	colorerFn   render.ColorerFunc
 	decorateFn  DecorateFunc
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	groupCol    string
+	groupMode   GroupMode
+	accessible  bool
 }
 
 // NewTable returns a new table view.
@@ -74,6 +78,9 @@ func (t *Table) Init(ctx context.Context) {
 	if cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView); ok && cfg != nil {
 		cfg.AddListener(t.GVR().String(), t)
 	}
+	if a, ok := ctx.Value(internal.KeyAccessible).(bool); ok {
+		t.accessible = a
+	}
 	t.styles = mustExtractStyles(ctx)
 	t.StylesChanged(t.styles)
 }
@@ -89,11 +96,13 @@ func (t *Table) ViewSettingsChanged(settings config.ViewSetting) {
 
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
-	t.SetBackgroundColor(s.Table().BgColor.Color())
+	ts := s.TableFor(t.gvr.String())
+	t.tableStyles = ts
+	t.SetBackgroundColor(ts.BgColor.Color())
 	t.SetBorderColor(s.Frame().Border.FgColor.Color())
 	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
-	t.SetSelectedStyle(tcell.StyleDefault.Foreground(t.styles.Table().CursorFgColor.Color()).Background(t.styles.Table().CursorBgColor.Color()).Attributes(tcell.AttrBold))
-	t.fgColor = s.Table().CursorFgColor.Color()
+	t.SetSelectedStyle(tcell.StyleDefault.Foreground(ts.CursorFgColor.Color()).Background(ts.CursorBgColor.Color()).Attributes(tcell.AttrBold))
+	t.fgColor = ts.CursorFgColor.Color()
 	t.Refresh()
 }
 
@@ -115,6 +124,38 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// IsWide returns true if wide columns are displayed.
+func (t *Table) IsWide() bool {
+	return t.wide
+}
+
+// IsToast returns true if only toast resources are displayed.
+func (t *Table) IsToast() bool {
+	return t.toast
+}
+
+// SortCol returns the current sort column name and direction.
+func (t *Table) SortCol() (string, bool) {
+	return t.sortCol.name, t.sortCol.asc
+}
+
+// GroupBy returns the current grouping column and mode.
+func (t *Table) GroupBy() (string, GroupMode) {
+	return t.groupCol, t.groupMode
+}
+
+// ToggleGroup cycles grouping modes on the current sort column.
+func (t *Table) ToggleGroup() {
+	t.groupMode = t.groupMode.Next()
+	switch t.groupMode {
+	case GroupNone:
+		t.groupCol = ""
+	case GroupExpanded:
+		t.groupCol = t.sortCol.name
+	}
+	t.Refresh()
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() KeyActions {
 	return t.actions
@@ -209,8 +250,8 @@ func (t *Table) doUpdate(data render.TableData) {
 	}
 
 	t.Clear()
-	fg := t.styles.Table().Header.FgColor.Color()
-	bg := t.styles.Table().Header.BgColor.Color()
+	ts := t.tableStyles
+	fg, bg := ts.Header.FgColor.Color(), ts.Header.BgColor.Color()
 
 	var col int
 	for _, h := range custData.Header {
@@ -226,6 +267,10 @@ func (t *Table) doUpdate(data render.TableData) {
 		c.SetTextColor(fg)
 		col++
 	}
+	if t.accessible {
+		t.AddHeaderCell(col, render.HeaderColumn{Name: StatusCol})
+		t.GetCell(0, col).SetBackgroundColor(bg).SetTextColor(fg)
+	}
 	colIndex := custData.Header.IndexOf(t.sortCol.name, false)
 	custData.RowEvents.Sort(
 		custData.Namespace,
@@ -237,20 +282,78 @@ func (t *Table) doUpdate(data render.TableData) {
 
 	pads := make(MaxyPad, len(custData.Header))
 	ComputeMaxColumns(pads, t.sortCol.name, custData.Header, custData.RowEvents)
+	if groupCol := custData.Header.IndexOf(t.groupCol, false); t.groupMode != GroupNone && groupCol >= 0 {
+		t.buildGroups(data, custData, groupCol, pads, ts)
+		t.updateSelection(true)
+		return
+	}
 	for row, re := range custData.RowEvents {
 		idx, _ := data.RowEvents.FindIndex(re.Row.ID)
-		t.buildRow(row+1, re, data.RowEvents[idx], custData.Header, pads)
+		t.buildRow(row+1, re, data.RowEvents[idx], custData.Header, pads, ts)
 	}
 	t.updateSelection(true)
 }
 
-func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads MaxyPad) {
+func (t *Table) buildGroups(data, custData render.TableData, groupCol int, pads MaxyPad, ts config.Table) {
+	row := 1
+	for _, g := range GroupRows(custData.RowEvents, groupCol) {
+		t.buildGroupRow(row, g, custData.Header, groupCol, ts)
+		row++
+		if t.groupMode == GroupCollapsed {
+			continue
+		}
+		for _, re := range g.RowEvents {
+			idx, _ := data.RowEvents.FindIndex(re.Row.ID)
+			t.buildRow(row, re, data.RowEvents[idx], custData.Header, pads, ts)
+			row++
+		}
+	}
+}
+
+func (t *Table) buildGroupRow(r int, g RowGroup, h render.Header, groupCol int, ts config.Table) {
+	fields := g.Aggregate(h)
+	fields[groupCol] = ""
+	fg, bg := ts.Header.FgColor.Color(), ts.Header.BgColor.Color()
+
+	var col int
+	for c, field := range fields {
+		if h[c].Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
+			continue
+		}
+		if h[c].MX && !t.hasMetrics {
+			continue
+		}
+		align := h[c].Align
+		if col == 0 {
+			field, align = g.Title(t.groupMode), tview.AlignLeft
+		}
+		cell := tview.NewTableCell(field)
+		cell.SetExpansion(1)
+		cell.SetAlign(align)
+		cell.SetTextColor(fg)
+		cell.SetBackgroundColor(bg)
+		cell.SetAttributes(tcell.AttrBold)
+		cell.SetSelectable(false)
+		t.SetCell(r, col, cell)
+		col++
+	}
+}
+
+func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads MaxyPad, ts config.Table) {
 	color := render.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
 
-	marked := t.IsMarked(re.Row.ID)
+	ns := t.GetModel().GetNamespace()
+	status := render.RowStatus(ns, t.header, ore)
+	fgColor, ok := t.ruleColor(ts.RowColors, ore)
+	if !ok {
+		fgColor = color(ns, t.header, ore)
+	}
+	if t.IsMarked(re.Row.ID) {
+		fgColor, status = ts.MarkColor.Color(), strings.TrimSpace(status+" MARKED")
+	}
 	var col int
 	for c, field := range re.Row.Fields {
 		if c >= len(h) {
@@ -279,17 +382,31 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 		cell := tview.NewTableCell(field)
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
-		fgColor := color(t.GetModel().GetNamespace(), t.header, ore)
 		cell.SetTextColor(fgColor)
-		if marked {
-			cell.SetTextColor(t.styles.Table().MarkColor.Color())
-		}
 		if col == 0 {
 			cell.SetReference(re.Row.ID)
 		}
 		t.SetCell(r, col, cell)
 		col++
 	}
+	if t.accessible {
+		t.SetCell(r, col, tview.NewTableCell(status).SetExpansion(1).SetTextColor(fgColor))
+	}
+}
+
+// ruleColor returns the color of the first skin row rule matching the row.
+func (t *Table) ruleColor(rr []config.RowColor, re render.RowEvent) (tcell.Color, bool) {
+	for i := range rr {
+		idx := t.header.IndexOf(rr[i].Column, true)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			continue
+		}
+		if rr[i].Matches(re.Row.Fields[idx]) {
+			return rr[i].Color.Color(), true
+		}
+	}
+
+	return tcell.ColorDefault, false
 }
 
 // SortColCmd designates a sorted column.
@@ -354,7 +471,7 @@ func (t *Table) NameColIndex() int {
 // AddHeaderCell configures a table cell header.
 func (t *Table) AddHeaderCell(col int, h render.HeaderColumn) {
 	sortCol := h.Name == t.sortCol.name
-	c := tview.NewTableCell(sortIndicator(sortCol, t.sortCol.asc, t.styles.Table(), h.Name))
+	c := tview.NewTableCell(sortIndicator(sortCol, t.sortCol.asc, t.tableStyles, h.Name))
 	c.SetExpansion(1)
 	c.SetAlign(h.Align)
 	t.SetCell(0, col, c)
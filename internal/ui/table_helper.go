@@ -17,6 +17,9 @@ const (
 	// DefaultColorName indicator to keep term colors.
 	DefaultColorName = "default"
 
+	// StatusCol represents the textual row status column in accessibility mode.
+	StatusCol = "ROW STATUS"
+
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
@@ -2,9 +2,11 @@ package ui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal"
 	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/config"
@@ -54,6 +56,97 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableViewState(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	assert.False(t, v.IsWide())
+	assert.False(t, v.IsToast())
+
+	v.ToggleWide()
+	v.ToggleToast()
+	v.SetSortCol("AGE", false)
+	assert.True(t, v.IsWide())
+	assert.True(t, v.IsToast())
+	col, asc := v.SortCol()
+	assert.Equal(t, "AGE", col)
+	assert.False(t, asc)
+}
+
+func TestTableRowColors(t *testing.T) {
+	s := config.NewStyles()
+	s.K9s.Views.Table.RowColors = []config.RowColor{
+		{Column: "c", Value: "zorg", Color: "red"},
+	}
+	assert.Nil(t, s.K9s.Views.Table.RowColors[0].Validate())
+	ctx := context.WithValue(makeContext(), internal.KeyStyles, s)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	v.SetModel(&mockModel{})
+	v.Update(makeTableData(), false)
+
+	assert.NotEqual(t, tcell.ColorRed, v.GetCell(1, 0).Color)
+	assert.Equal(t, tcell.ColorRed, v.GetCell(2, 0).Color)
+}
+
+func TestTableStylesChanged(t *testing.T) {
+	s := config.NewStyles()
+	ctx := context.WithValue(makeContext(), internal.KeyStyles, s)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	v.SetModel(&mockModel{})
+	v.Update(makeTableData(), false)
+	assert.NotEqual(t, tcell.ColorRed, v.GetCell(2, 0).Color)
+
+	rr := []config.RowColor{{Column: "c", Value: "zorg", Color: "red"}}
+	assert.Nil(t, rr[0].Validate())
+	s.K9s.Views.Tables = map[string]config.Table{"fred": {RowColors: rr}}
+	v.StylesChanged(s)
+	v.Update(makeTableData(), false)
+	assert.Equal(t, tcell.ColorRed, v.GetCell(2, 0).Color)
+}
+
+func TestTableAccessible(t *testing.T) {
+	render.StdColor = tcell.ColorWhite
+	defer func() { render.StdColor = tcell.ColorDefault }()
+	ctx := context.WithValue(makeContext(), internal.KeyAccessible, true)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	v.SetModel(&mockModel{})
+	v.Update(makeTableData(), false)
+	v.ToggleMark()
+	v.Refresh()
+
+	assert.Equal(t, 4, v.GetColumnCount())
+	assert.Equal(t, "OK MARKED", v.GetCell(1, 3).Text)
+	assert.Equal(t, ui.StatusCol, strings.TrimSpace(v.GetCell(0, 3).Text))
+	assert.Equal(t, "OK", v.GetCell(2, 3).Text)
+	assert.Equal(t, "fred", v.GetSelectedCell(2))
+}
+
+func TestTableAccessibleRowColor(t *testing.T) {
+	render.ErrColor = tcell.ColorRed
+	defer func() { render.ErrColor = tcell.ColorDefault }()
+	s := config.NewStyles()
+	rr := []config.RowColor{{Column: "c", Value: "zorg", Color: "red"}}
+	assert.Nil(t, rr[0].Validate())
+	s.K9s.Views.Tables = map[string]config.Table{"fred": {RowColors: rr}}
+	ctx := context.WithValue(makeContext(), internal.KeyStyles, s)
+	ctx = context.WithValue(ctx, internal.KeyAccessible, true)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	v.SetModel(&mockModel{})
+	v.Update(makeTableData(), false)
+
+	assert.Equal(t, tcell.ColorRed, v.GetCell(2, 0).Color)
+	assert.Equal(t, "OK", v.GetCell(2, 3).Text)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
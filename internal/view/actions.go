@@ -1,7 +1,10 @@
 package view
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
 
 	"github.com/open-infra/osc/internal/config"
@@ -77,6 +80,85 @@ func hotKeyActions(r Runner, aa ui.KeyActions) {
 	}
 }
 
+// keymapActions rebinds actions based on the user key maps for the given scopes.
+// Key maps are validated once on load hence invalid shortcuts are skipped.
+func keymapActions(app *App, scopes []string, aa ui.KeyActions) {
+	if app == nil {
+		return
+	}
+	bb := app.keyMaps.BindingsFor(scopes)
+	if len(bb) == 0 {
+		return
+	}
+	kk := make(map[string]tcell.Key, len(bb))
+	for a, sc := range bb {
+		if key, err := asKey(sc); err == nil {
+			kk[strings.ToLower(a)] = key
+		}
+	}
+	for _, a := range aa.Remap(kk) {
+		msg := fmt.Sprintf("%s:%s", strings.Join(scopes, ","), a.Description)
+		if _, ok := app.keyMapWarns.LoadOrStore(msg, struct{}{}); !ok {
+			log.Warn().Msgf("KEY-MAP %q binding was overridden by a remapped action", a.Description)
+		}
+	}
+}
+
+// loadKeyMaps loads and validates the user key maps. Conflicting bindings and
+// bindings displacing the given built-in actions are reported.
+func loadKeyMaps(aa ui.KeyActions) (config.KeyMaps, error) {
+	km := config.NewKeyMaps()
+	if err := km.Load(); err != nil {
+		if os.IsNotExist(err) {
+			return km, nil
+		}
+		return km, err
+	}
+
+	var errs []string
+	if err := km.Validate(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if cc := builtinConflicts(km, aa); len(cc) > 0 {
+		errs = append(errs, "keymap built-in conflicts: "+strings.Join(cc, "; "))
+	}
+	if len(errs) == 0 {
+		return km, nil
+	}
+
+	return km, errors.New(strings.Join(errs, " -- "))
+}
+
+// builtinConflicts lists the key map bindings displacing the given built-in
+// actions unless those actions are remapped too.
+func builtinConflicts(km config.KeyMaps, aa ui.KeyActions) []string {
+	var cc []string
+	for s, m := range km.KeyMap {
+		remapped := make(map[string]struct{}, len(m))
+		for a := range km.BindingsFor([]string{s}) {
+			remapped[strings.ToLower(a)] = struct{}{}
+		}
+		for a, sc := range m {
+			key, err := asKey(sc)
+			if err != nil {
+				cc = append(cc, fmt.Sprintf("%s: unable to map %q shortcut %q", s, a, sc))
+				continue
+			}
+			old, ok := aa[key]
+			if !ok || strings.EqualFold(old.Description, a) {
+				continue
+			}
+			if _, ok := remapped[strings.ToLower(old.Description)]; ok {
+				continue
+			}
+			cc = append(cc, fmt.Sprintf("%s: %q displaces %s", s, strings.ToLower(sc), old.Description))
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
 func gotoCmd(r Runner, cmd, path string) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		if err := r.App().gotoResource(cmd, path, true); err != nil {
@@ -135,6 +217,10 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 		}
 
 		cb := func() {
+			if p.IsRendered() {
+				showPlugin(r.App(), p, args)
+				return
+			}
 			opts := shellOpts{
 				clear:      true,
 				binary:     p.Command,
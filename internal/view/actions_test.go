@@ -3,6 +3,9 @@ package view
 import (
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/ui"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
@@ -11,6 +14,21 @@ func init() {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
 }
 
+func TestBuiltinConflicts(t *testing.T) {
+	aa := ui.KeyActions{
+		tcell.KeyCtrlA: ui.NewKeyAction("Aliases", nil, true),
+		tcell.KeyCtrlE: ui.NewKeyAction("Toggle Header", nil, true),
+	}
+	km := config.NewKeyMaps()
+	km.KeyMap["all"] = config.KeyMap{"Help": "Ctrl-A"}
+	km.KeyMap["pods"] = config.KeyMap{"Shell": "Ctrl-E", "Toggle Header": "Ctrl-B", "Logs": "Blee"}
+
+	assert.Equal(t, []string{
+		`all: "ctrl-a" displaces Aliases`,
+		`pods: unable to map "Logs" shortcut "Blee"`,
+	}, builtinConflicts(km, aa))
+}
+
 func TestHasAll(t *testing.T) {
 	uu := map[string]struct {
 		scopes []string
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+// NodeAlloc represents a node allocation viewer.
+type NodeAlloc struct {
+	ResourceViewer
+}
+
+// NewNodeAlloc returns a new viewer.
+func NewNodeAlloc(gvr client.GVR) ResourceViewer {
+	n := NodeAlloc{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetColorerFn(render.NodeAlloc{}.ColorerFunc())
+	n.GetTable().SetDecorateFn(n.decorate)
+	n.GetTable().SetEnterFn(n.showPods)
+
+	return &n
+}
+
+func (n *NodeAlloc) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU/R", n.GetTable().SortColCmd("%CPU/R", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM/R", n.GetTable().SortColCmd("%MEM/R", false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Overcommit", n.GetTable().SortColCmd("OVERCOMMIT", false), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("%PODS", false), false),
+	})
+}
+
+func (n *NodeAlloc) decorate(data render.TableData) render.TableData {
+	return decorateAllocRows(n.App(), data)
+}
+
+func (n *NodeAlloc) showPods(app *App, _ ui.Tabular, _, path string) {
+	showPodAllocs(app, path)
+}
+
+// PodAlloc represents a node pods allocation viewer.
+type PodAlloc struct {
+	ResourceViewer
+}
+
+// NewPodAlloc returns a new viewer.
+func NewPodAlloc(gvr client.GVR) ResourceViewer {
+	p := PodAlloc{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+	p.GetTable().SetColorerFn(render.PodAlloc{}.ColorerFunc())
+	p.GetTable().SetSortCol("CPU/R", false)
+	p.GetTable().SetEnterFn(p.showContainers)
+
+	return &p
+}
+
+func (p *PodAlloc) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU/R", p.GetTable().SortColCmd("CPU/R", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM/R", p.GetTable().SortColCmd("MEM/R", false), false),
+		ui.KeyShiftQ: ui.NewKeyAction("Sort QOS", p.GetTable().SortColCmd("QOS", true), false),
+	})
+}
+
+func (p *PodAlloc) showContainers(app *App, _ ui.Tabular, _, path string) {
+	co := NewContainer(client.NewGVR("containers"))
+	co.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(co); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showPodAllocs(app *App, path string) {
+	v := NewPodAlloc(client.NewGVR("podallocations"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// decorateAllocRows colors allocation percentages based on the configured thresholds.
+func decorateAllocRows(app *App, data render.TableData) render.TableData {
+	for colIndex, header := range data.Header {
+		var check string
+		switch header.Name {
+		case "%CPU/R", "%CPU/L":
+			check = "cpu"
+		case "%MEM/R", "%MEM/L":
+			check = "memory"
+		default:
+			continue
+		}
+		colorSeverity(app, data, colIndex, check)
+	}
+
+	return data
+}
@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -49,6 +50,8 @@ type App struct {
 	conRetry      int32
 	showHeader    bool
 	showCrumbs    bool
+	keyMaps       config.KeyMaps
+	keyMapWarns   sync.Map
 }
 
 // NewApp returns a K9s app instance.
@@ -85,6 +88,13 @@ func (a *App) Init(version string, rate int) error {
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
+	km, err := loadKeyMaps(a.GetActions())
+	if err != nil {
+		log.Warn().Err(err).Msg("KEY-MAP load failed")
+		a.Flash().Warn(err.Error())
+	}
+	a.keyMaps = km
+	keymapActions(a, []string{"app"}, a.GetActions())
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
@@ -157,6 +167,12 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 			return a.cmdHistory.List()
 		}
 
+		if ss := suggestBookmarks(a.Config.Bookmarks(), s); len(ss) > 0 {
+			entries = ss
+			entries.Sort()
+			return
+		}
+
 		s = strings.ToLower(s)
 		for _, k := range a.command.alias.Aliases.Keys() {
 			if k == s {
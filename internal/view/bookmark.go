@@ -0,0 +1,155 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+)
+
+const (
+	bookmarkCmd       = "bm"
+	saveBookmarkCmd   = "bm+"
+	deleteBookmarkCmd = "bm-"
+)
+
+// bookmarkCmd recalls, saves or deletes a named bookmark.
+func (c *Command) bookmarkCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if len(tokens) != 2 {
+		nn := c.app.Config.Bookmarks().Names()
+		if len(nn) == 0 {
+			return errors.New("No bookmarks defined. Use `bm+ NAME` to bookmark the current view")
+		}
+		c.app.Flash().Infof("Bookmarks: %s", strings.Join(nn, ", "))
+		return nil
+	}
+
+	name := tokens[1]
+	switch tokens[0] {
+	case saveBookmarkCmd:
+		return c.saveBookmark(name)
+	case deleteBookmarkCmd:
+		if err := c.app.Config.DeleteBookmark(name); err != nil {
+			return err
+		}
+		if err := c.app.Config.Save(); err != nil {
+			return err
+		}
+		c.app.Flash().Infof("Bookmark %q deleted", name)
+		return nil
+	default:
+		return c.gotoBookmark(name)
+	}
+}
+
+func (c *Command) saveBookmark(name string) error {
+	v, ok := c.app.Content.Top().(ResourceViewer)
+	if !ok || v.GetTable().Path != "" {
+		return errors.New("Only top level resource views can be bookmarked")
+	}
+	bm, err := newBookmark(v)
+	if err != nil {
+		return err
+	}
+	if !c.alias.Check(bm.Command) {
+		return fmt.Errorf("View %q can not be bookmarked", bm.Command)
+	}
+	if err := c.app.Config.SetBookmark(name, bm); err != nil {
+		return err
+	}
+	if err := c.app.Config.Save(); err != nil {
+		return err
+	}
+	c.app.Flash().Infof("Bookmark %q saved", name)
+
+	return nil
+}
+
+func (c *Command) gotoBookmark(name string) error {
+	bm, ok := c.app.Config.Bookmarks()[name]
+	if !ok {
+		return fmt.Errorf("No bookmark named %q", name)
+	}
+	cmd := bm.Command
+	if bm.Namespace != "" {
+		cmd += " " + bm.Namespace
+	}
+	if err := c.run(cmd, "", true); err != nil {
+		return err
+	}
+	v, ok := c.app.Content.Top().(ResourceViewer)
+	if !ok {
+		return nil
+	}
+	applyBookmark(v, bm)
+
+	return nil
+}
+
+// newBookmark captures a resource viewer current setup.
+func newBookmark(v ResourceViewer) (config.Bookmark, error) {
+	meta, err := dao.MetaAccess.MetaFor(v.GVR())
+	if err != nil {
+		return config.Bookmark{}, err
+	}
+	t := v.GetTable()
+	bm := config.Bookmark{
+		Command: v.GVR().String(),
+		Filter:  t.CmdBuff().GetText(),
+		Wide:    t.IsWide(),
+		Toast:   t.IsToast(),
+	}
+	bm.SortColumn, bm.SortAsc = t.SortCol()
+	if meta.Namespaced {
+		bm.Namespace = t.GetModel().GetNamespace()
+		if client.IsAllNamespaces(bm.Namespace) {
+			bm.Namespace = client.NamespaceAll
+		}
+	}
+
+	return bm, nil
+}
+
+// applyBookmark restores a bookmarked setup on a resource viewer.
+func applyBookmark(v ResourceViewer, bm config.Bookmark) {
+	t := v.GetTable()
+	if bm.SortColumn != "" {
+		t.SetSortCol(bm.SortColumn, bm.SortAsc)
+	}
+	if t.IsWide() != bm.Wide {
+		t.ToggleWide()
+	}
+	if t.IsToast() != bm.Toast {
+		t.ToggleToast()
+	}
+	if bm.Filter != "" {
+		t.CmdBuff().SetText(bm.Filter)
+		t.CmdBuff().SetActive(false)
+	}
+}
+
+// suggestBookmarks returns bookmark names completions for a bookmark command.
+func suggestBookmarks(bb config.Bookmarks, s string) []string {
+	tokens := strings.SplitN(s, " ", 2)
+	if len(tokens) != 2 {
+		return nil
+	}
+	switch tokens[0] {
+	case bookmarkCmd, deleteBookmarkCmd:
+	default:
+		return nil
+	}
+
+	var ss []string
+	for _, n := range bb.Names() {
+		if n != tokens[1] && strings.HasPrefix(n, tokens[1]) {
+			ss = append(ss, strings.TrimPrefix(n, tokens[1]))
+		}
+	}
+
+	return ss
+}
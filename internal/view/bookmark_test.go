@@ -0,0 +1,36 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestBookmarks(t *testing.T) {
+	bb := config.Bookmarks{
+		"prod-pods": {Command: "v1/pods"},
+		"prod-dps":  {Command: "apps/v1/deployments"},
+		"staging":   {Command: "v1/pods"},
+	}
+
+	uu := map[string]struct {
+		s string
+		e []string
+	}{
+		"no-cmd":  {s: "po prod"},
+		"no-name": {s: "bm"},
+		"all":     {s: "bm ", e: []string{"prod-dps", "prod-pods", "staging"}},
+		"prefix":  {s: "bm prod-", e: []string{"dps", "pods"}},
+		"delete":  {s: "bm- st", e: []string{"aging"}},
+		"exact":   {s: "bm staging"},
+		"save":    {s: "bm+ prod"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, suggestBookmarks(bb, u.s))
+		})
+	}
+}
@@ -65,6 +65,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	for _, f := range b.bindKeysFn {
 		f(b.Actions())
 	}
+	keymapActions(b.app, b.Aliases(), b.Actions())
 	b.accessor, err = dao.AccessorFor(b.app.factory, b.GVR())
 	if err != nil {
 		return err
@@ -234,7 +235,7 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	v := NewLiveView(b.app, "YAML", model.NewYAML(b.GVR(), path))
+	v := NewLiveView(b.app, "YAML", model.NewYAML(b.GVR(), path)).SetExplainGVR(b.GVR())
 	if err := v.app.inject(v); err != nil {
 		v.app.Flash().Err(err)
 	}
@@ -326,6 +327,37 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) patchMetaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+	if len(paths) == 1 {
+		editMeta(b, paths[0])
+		return nil
+	}
+
+	ShowMetaPatch(b, paths, patchMeta)
+
+	return nil
+}
+
+func patchMeta(v ResourceViewer, paths []string, p dao.MetaPatch) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	m, ok := res.(dao.MetaPatcher)
+	if !ok {
+		v.App().Flash().Err(fmt.Errorf("expecting a meta patcher for %q", v.GVR()))
+		return
+	}
+	runBulk(v, "Label/Annotate", paths, func(ctx context.Context, path string) error {
+		return m.PatchMeta(ctx, path, p)
+	})
+}
+
 func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -427,7 +459,11 @@ func (b *Browser) defaultContext() context.Context {
 }
 
 func (b *Browser) refreshActions() {
-	if b.App().Content.Top().Name() != b.Name() {
+	split, inSplit := b.App().Content.Top().(*Split)
+	if inSplit && !split.HasTable(b.GetTable()) {
+		return
+	}
+	if !inSplit && b.App().Content.Top().Name() != b.Name() {
 		return
 	}
 	aa := ui.KeyActions{
@@ -442,6 +478,9 @@ func (b *Browser) refreshActions() {
 			if client.Can(b.meta.Verbs, "edit") {
 				aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
 			}
+			if client.Can(b.meta.Verbs, "patch") && !dao.IsK9sMeta(b.meta) {
+				aa[tcell.KeyCtrlP] = ui.NewKeyAction("Label/Annotate", b.patchMetaCmd, true)
+			}
 			if client.Can(b.meta.Verbs, "delete") {
 				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
 			}
@@ -459,6 +498,13 @@ func (b *Browser) refreshActions() {
 		f(aa)
 	}
 	b.Actions().Add(aa)
+	keymapActions(b.app, b.Aliases(), b.Actions())
+	if inSplit {
+		if split.IsFocused(b.GetTable()) {
+			b.app.Menu().HydrateMenu(split.Hints())
+		}
+		return
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/ui/dialog"
+)
+
+// runBulk runs an operation on the given resources concurrently. A single
+// resource outcome is flashed, otherwise a results dialog lists all outcomes.
+func runBulk(v ResourceViewer, op string, paths []string, f dao.BulkFunc) {
+	app := v.App()
+	timeout := app.Conn().Config().CallTimeout()
+	go func() {
+		rr := dao.BulkRun(context.Background(), paths, dao.BulkWorkers, func(ctx context.Context, path string) error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return f(ctx, path)
+		})
+		app.QueueUpdateDraw(func() {
+			defer v.Refresh()
+			if len(rr) == 1 {
+				if rr[0].Err != nil {
+					app.Flash().Errf("%s failed for %s: %s", op, rr[0].Path, rr[0].Err)
+					return
+				}
+				app.Flash().Infof("%s succeeded for %s", op, rr[0].Path)
+				return
+			}
+			res := make([]dialog.Result, 0, len(rr))
+			for _, r := range rr {
+				res = append(res, dialog.Result{Item: r.Path, Err: r.Err})
+			}
+			dialog.ShowResults(app.Styles.Dialog(), app.Content.Pages, op+" Results", res)
+		})
+	}()
+}
+
+// bulkMsg returns a confirmation message for one or more resources of a given kind.
+func bulkMsg(op, kind string, paths []string) string {
+	if len(paths) == 1 {
+		return fmt.Sprintf("%s %s %s?", op, kind, paths[0])
+	}
+
+	return fmt.Sprintf("%s %d %ss?", op, len(paths), kind)
+}
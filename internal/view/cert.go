@@ -0,0 +1,136 @@
+package view
+
+import (
+	"crypto/x509"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// Cert represents a TLS certificates viewer.
+type Cert struct {
+	ResourceViewer
+}
+
+// NewCert returns a new viewer.
+func NewCert(gvr client.GVR) ResourceViewer {
+	c := Cert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.AddBindKeysFn(c.bindKeys)
+	c.GetTable().SetColorerFn(c.colorer)
+	c.GetTable().SetSortCol("DAYS", true)
+	c.GetTable().SetEnterFn(c.showChain)
+
+	return &c
+}
+
+func (c *Cert) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Days", c.GetTable().SortColCmd("DAYS", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Secret", c.GetTable().SortColCmd("SECRET", true), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Issuer", c.GetTable().SortColCmd("ISSUER", true), false),
+	})
+}
+
+// colorer colors certificates rows based on the configured expiry windows.
+func (c *Cert) colorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	col := h.IndexOf("DAYS", true)
+	if col < 0 {
+		return render.Cert{}.ColorerFunc()(ns, h, re)
+	}
+	if color, ok := certDaysColor(c.App().Config.Osc.CertExpiry, re.Row.Fields[col]); ok {
+		return tcell.GetColor(color)
+	}
+
+	return render.Cert{}.ColorerFunc()(ns, h, re)
+}
+
+func (c *Cert) showChain(app *App, _ ui.Tabular, _, path string) {
+	fqn := strings.Split(path, ":")[0]
+	o, err := app.factory.Get("v1/secrets", fqn, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sec); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	cc, err := dao.ParseCertChain(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	raw, err := yaml.Marshal(certsInfo(cc))
+	if err != nil {
+		app.Flash().Errf("Error decoding certificates %s", err)
+		return
+	}
+
+	details := NewDetails(app, "Certificate Chain", fqn, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// certDaysColor returns the color for the given days left before expiry if
+// within the expiry windows.
+func certDaysColor(exp *config.CertExpiry, days string) (string, bool) {
+	if exp == nil {
+		exp = config.NewCertExpiry()
+	}
+	n, err := strconv.Atoi(days)
+	if err != nil || exp.LevelFor(n) == config.SeverityLow {
+		return "", false
+	}
+
+	return exp.SeverityColor(n), true
+}
+
+type certInfo struct {
+	Subject   string    `json:"subject"`
+	Issuer    string    `json:"issuer"`
+	Serial    string    `json:"serial"`
+	SANs      []string  `json:"sans,omitempty"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+	IsCA      bool      `json:"isCA"`
+}
+
+func certsInfo(cc []*x509.Certificate) []certInfo {
+	ii := make([]certInfo, 0, len(cc))
+	for _, cert := range cc {
+		sans := append([]string{}, cert.DNSNames...)
+		for _, ip := range cert.IPAddresses {
+			sans = append(sans, ip.String())
+		}
+		ii = append(ii, certInfo{
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			Serial:    cert.SerialNumber.String(),
+			SANs:      sans,
+			NotBefore: cert.NotBefore.UTC(),
+			NotAfter:  cert.NotAfter.UTC(),
+			IsCA:      cert.IsCA,
+		})
+	}
+
+	return ii
+}
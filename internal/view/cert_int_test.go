@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertDaysColor(t *testing.T) {
+	exp := &config.CertExpiry{Critical: 7, Warn: 30}
+
+	uu := map[string]struct {
+		days  string
+		color string
+		ok    bool
+	}{
+		"fine":     {days: "45"},
+		"warn":     {days: "20", color: "orangered", ok: true},
+		"critical": {days: "3", color: "red", ok: true},
+		"expired":  {days: "-2", color: "red", ok: true},
+		"blank":    {days: "n/a"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := certDaysColor(exp, u.days)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.color, c)
+		})
+	}
+}
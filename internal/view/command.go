@@ -107,6 +107,28 @@ func (c *Command) xrayCmd(cmd string) error {
 	return c.exec(cmd, "xrays", x, true)
 }
 
+func (c *Command) netCheckCmd(cmd string) error {
+	spec, err := parseNetCheck(strings.Fields(cmd)[1:], c.app.Config.ActiveNamespace())
+	if err != nil {
+		return err
+	}
+
+	return c.exec(cmd, "netchecks", newNetCheckView(spec), false)
+}
+
+func (c *Command) explainCmd(cmd string) error {
+	var arg string
+	if tokens := strings.Fields(cmd); len(tokens) > 1 {
+		arg = tokens[1]
+	}
+	gvr, path, err := parseExplain(arg, c.alias.AsGVR)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewExplain(gvr, path))
+}
+
 // Exec the Command by showing associated display.
 func (c *Command) run(cmd, path string, clearStack bool) error {
 	if c.specialCmd(cmd, path) {
@@ -191,6 +213,26 @@ func (c *Command) specialCmd(cmd, path string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "netcheck", "nc":
+		if err := c.netCheckCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "explain":
+		if err := c.explainCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case splitCmd, vsplitCmd:
+		if err := c.splitCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case bookmarkCmd, saveBookmarkCmd, deleteBookmarkCmd:
+		if err := c.bookmarkCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -2,11 +2,14 @@ package view
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/dao"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/open-infra/osc/internal/ui"
+	"github.com/open-infra/osc/internal/ui/dialog"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rs/zerolog/log"
 )
@@ -30,6 +33,109 @@ func NewContext(gvr client.GVR) ResourceViewer {
 
 func (c *Context) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	if c.App().Config.Osc.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR:      ui.NewKeyAction("Rename", c.renameCmd, true),
+		ui.KeyN:      ui.NewKeyAction("Set Namespace", c.namespaceCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Duplicate", c.dupCmd, true),
+	})
+}
+
+func (c *Context) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := c.GetTable().GetSelectedItem()
+	if n == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Rename context %s", n)
+	c.showInput("Rename", msg, "Name:", n, func(kc dao.KubeConfigurable, s string) error {
+		if s == "" || s == n {
+			return nil
+		}
+		if err := kc.Rename(n, s); err != nil {
+			return err
+		}
+		c.App().Flash().Infof("Context %s renamed to %s", n, s)
+		return nil
+	})
+
+	return nil
+}
+
+func (c *Context) namespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := c.GetTable().GetSelectedItem()
+	if n == "" {
+		return evt
+	}
+
+	ns := c.selectedNamespace(n)
+	msg := fmt.Sprintf("Set context %s default namespace", n)
+	c.showInput("Set Namespace", msg, "Namespace:", ns, func(kc dao.KubeConfigurable, s string) error {
+		if err := kc.SetNamespace(n, s); err != nil {
+			return err
+		}
+		c.App().Flash().Infof("Context %s default namespace set to %q", n, s)
+		return nil
+	})
+
+	return nil
+}
+
+// selectedNamespace returns the given context default namespace if any.
+func (c *Context) selectedNamespace(path string) string {
+	row, ok := c.GetTable().GetSelectedRow(path)
+	if !ok {
+		return ""
+	}
+	data := c.GetTable().GetModel().Peek()
+	col := data.IndexOfHeader("NAMESPACE")
+	if col < 0 || col >= len(row.Fields) {
+		return ""
+	}
+
+	return strings.TrimSpace(row.Fields[col])
+}
+
+func (c *Context) dupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := c.GetTable().GetSelectedItem()
+	if n == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Duplicate context %s", n)
+	c.showInput("Duplicate", msg, "Name:", n+"-copy", func(kc dao.KubeConfigurable, s string) error {
+		if s == "" {
+			return nil
+		}
+		if err := kc.Duplicate(n, s); err != nil {
+			return err
+		}
+		c.App().Flash().Infof("Context %s duplicated as %s", n, s)
+		return nil
+	})
+
+	return nil
+}
+
+func (c *Context) showInput(title, msg, label, value string, apply func(dao.KubeConfigurable, string) error) {
+	c.Stop()
+	dialog.ShowInput(c.App().Styles.Dialog(), c.App().Content.Pages, title, msg, label, value, func(s string) {
+		defer c.Start()
+		kc, err := kubeConfigurable(c.App())
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		if err := apply(kc, s); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.Refresh()
+	}, func() {
+		c.Start()
+	})
 }
 
 func (c *Context) useCtx(app *App, model ui.Tabular, gvr, path string) {
@@ -61,3 +167,16 @@ func useContext(app *App, name string) error {
 
 	return app.switchCtx(name, true)
 }
+
+func kubeConfigurable(app *App) (dao.KubeConfigurable, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("contexts"))
+	if err != nil {
+		return nil, err
+	}
+	kc, ok := res.(dao.KubeConfigurable)
+	if !ok {
+		return nil, errors.New("expecting a kubeconfig manager")
+	}
+
+	return kc, nil
+}
@@ -0,0 +1,300 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const (
+	crdTitle      = "CRD"
+	crdInfoSize   = 7
+	crdMaxCounts  = 8
+	crdScopeLabel = "<cluster>"
+)
+
+// CRD represents a custom resource definition explorer.
+type CRD struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	path     string
+	info     *dao.CRDInfo
+	counts   map[string]int
+	version  int
+	header   *tview.TextView
+	schema   *SchemaTree
+	actions  ui.KeyActions
+	cancelFn context.CancelFunc
+}
+
+// NewCRD returns a new CRD explorer.
+func NewCRD(gvr client.GVR, path string) *CRD {
+	return &CRD{
+		Flex:    tview.NewFlex(),
+		gvr:     gvr,
+		path:    path,
+		header:  tview.NewTextView(),
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the view.
+func (c *CRD) Init(ctx context.Context) error {
+	var err error
+	if c.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	c.SetBorder(true)
+	c.SetTitle(fmt.Sprintf(" %s([::b]%s[::-]) ", crdTitle, c.path))
+	c.SetBorderPadding(0, 0, 1, 1)
+	c.SetDirection(tview.FlexRow)
+	c.header.SetDynamicColors(true)
+	c.header.SetWrap(false)
+	c.schema = NewSchemaTree(c.app.Styles)
+	c.AddItem(c.header, crdInfoSize, 0, false)
+	c.AddItem(c.schema, 0, 1, true)
+
+	c.bindKeys()
+	c.SetInputCapture(c.keyboard)
+	c.app.Styles.AddListener(c)
+	c.StylesChanged(c.app.Styles)
+
+	return nil
+}
+
+// Name returns the component name.
+func (c *CRD) Name() string {
+	return crdTitle
+}
+
+// Start loads the CRD definition and its instances.
+func (c *CRD) Start() {
+	c.cancel()
+
+	var ctx context.Context
+	ctx, c.cancelFn = context.WithCancel(context.Background())
+	go c.load(ctx)
+}
+
+// Stop cancels any pending loads.
+func (c *CRD) Stop() {
+	c.cancel()
+	c.app.Styles.RemoveListener(c)
+}
+
+func (c *CRD) cancel() {
+	if c.cancelFn == nil {
+		return
+	}
+	c.cancelFn()
+	c.cancelFn = nil
+}
+
+// Actions returns active menu bindings.
+func (c *CRD) Actions() ui.KeyActions {
+	return c.actions
+}
+
+// Hints returns the view hints.
+func (c *CRD) Hints() model.MenuHints {
+	return c.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (c *CRD) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (c *CRD) StylesChanged(s *config.Styles) {
+	c.SetBackgroundColor(s.BgColor())
+	c.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	c.header.SetBackgroundColor(s.BgColor())
+	c.header.SetTextColor(s.FgColor())
+	c.schema.StylesChanged(s)
+	c.refreshHeader()
+}
+
+func (c *CRD) load(ctx context.Context) {
+	res, err := dao.AccessorFor(c.app.factory, c.gvr)
+	if err != nil {
+		c.app.Flash().Err(err)
+		return
+	}
+	crd, ok := res.(*dao.CustomResourceDefinition)
+	if !ok {
+		c.app.Flash().Errf("expecting a crd accessor but got %T", res)
+		return
+	}
+	info, err := crd.Info(c.path)
+	if err != nil {
+		c.app.Flash().Err(err)
+		return
+	}
+	c.app.QueueUpdateDraw(func() {
+		c.info, c.version = info, info.DefaultVersion()
+		c.refresh()
+	})
+
+	counts, err := crd.Instances(info)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		c.app.Flash().Err(err)
+		return
+	}
+	c.app.QueueUpdateDraw(func() {
+		c.counts = counts
+		c.refreshHeader()
+	})
+}
+
+func (c *CRD) bindKeys() {
+	c.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.app.PrevCmd, false),
+		ui.KeyI:         ui.NewKeyAction("Instances", c.instancesCmd, true),
+		ui.KeyShiftV:    ui.NewKeyAction("Next Version", c.nextVersionCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Expand/Collapse All", c.toggleAllCmd, true),
+	})
+}
+
+func (c *CRD) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := c.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (c *CRD) instancesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	gotoCRDInstances(c.app, c.path)
+	return nil
+}
+
+func (c *CRD) nextVersionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if c.info == nil || len(c.info.Versions) < 2 {
+		return nil
+	}
+	c.version = (c.version + 1) % len(c.info.Versions)
+	c.refresh()
+
+	return nil
+}
+
+func (c *CRD) toggleAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	c.schema.ToggleAll()
+	return nil
+}
+
+func (c *CRD) refresh() {
+	c.refreshHeader()
+	if c.info == nil {
+		return
+	}
+	v := c.info.Versions[c.version]
+	if v.Schema == nil {
+		c.app.Flash().Warnf("No schema defined for version %s", v.Name)
+	}
+	c.schema.SetRoot(v.Schema)
+}
+
+func (c *CRD) refreshHeader() {
+	if c.info == nil {
+		return
+	}
+	c.header.SetText(crdHeader(c.info, c.version, c.counts, c.app.Styles))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showCRD(app *App, _ ui.Tabular, gvr, path string) {
+	if err := app.inject(NewCRD(client.NewGVR(gvr), path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func gotoCRDInstances(app *App, path string) {
+	_, crdGVR := client.Namespaced(path)
+	tokens := strings.Split(crdGVR, ".")
+	if err := app.gotoResource(tokens[0], "", false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func crdHeader(info *dao.CRDInfo, version int, counts map[string]int, s *config.Styles) string {
+	section, fg := s.K9s.Info.SectionColor.String(), s.Body().FgColor.String()
+	hilite := s.Frame().Status.HighlightColor.String()
+
+	var b strings.Builder
+	field := func(k, v string) {
+		fmt.Fprintf(&b, "[%s::b]%-11s[%s::-]%s\n", section, k+":", fg, v)
+	}
+	field("Group", info.Group)
+	field("Kind", fmt.Sprintf("%s (%s)", info.Kind, info.Plural))
+	scope := info.Scope
+	if scope == "" {
+		scope = "Namespaced"
+	}
+	field("Scope", scope)
+
+	vv := make([]string, 0, len(info.Versions))
+	for i, v := range info.Versions {
+		var flags []string
+		if v.Served {
+			flags = append(flags, "served")
+		}
+		if v.Storage {
+			flags = append(flags, "storage")
+		}
+		label := v.Name
+		if len(flags) > 0 {
+			label += "(" + strings.Join(flags, ",") + ")"
+		}
+		if i == version {
+			label = "[" + hilite + "::b]" + label + "[" + fg + "::-]"
+		}
+		vv = append(vv, label)
+	}
+	field("Versions", strings.Join(vv, "  "))
+	field("Instances", crdCounts(counts))
+
+	return b.String()
+}
+
+func crdCounts(counts map[string]int) string {
+	if counts == nil {
+		return "loading..."
+	}
+	var total int
+	for _, n := range counts {
+		total += n
+	}
+	ss := []string{strconv.Itoa(total) + " total"}
+	for i, ns := range dao.SortedCounts(counts) {
+		if i == crdMaxCounts {
+			ss = append(ss, fmt.Sprintf("+%d more", len(counts)-crdMaxCounts))
+			break
+		}
+		label := ns
+		if client.IsClusterScoped(ns) {
+			label = crdScopeLabel
+		}
+		ss = append(ss, fmt.Sprintf("%s=%d", label, counts[ns]))
+	}
+
+	return strings.Join(ss, "  ")
+}
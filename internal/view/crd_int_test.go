@@ -0,0 +1,47 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRDCounts(t *testing.T) {
+	uu := map[string]struct {
+		counts map[string]int
+		e      string
+	}{
+		"loading": {
+			e: "loading...",
+		},
+		"none": {
+			counts: map[string]int{},
+			e:      "0 total",
+		},
+		"namespaced": {
+			counts: map[string]int{"ns1": 1, "ns2": 3},
+			e:      "4 total  ns2=3  ns1=1",
+		},
+		"cluster": {
+			counts: map[string]int{"-": 2},
+			e:      "2 total  <cluster>=2",
+		},
+		"capped": {
+			counts: map[string]int{"a": 9, "b": 8, "c": 7, "d": 6, "e": 5, "f": 4, "g": 3, "h": 2, "i": 1, "j": 1},
+			e:      "46 total  a=9  b=8  c=7  d=6  e=5  f=4  g=3  h=2  +2 more",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, crdCounts(u.counts))
+		})
+	}
+}
+
+func TestSchemaLabel(t *testing.T) {
+	assert.Equal(t, "replicas <integer> *", schemaLabel(&dao.SchemaField{Name: "replicas", Type: "integer", Required: true}))
+	assert.Equal(t, "mode <string>", schemaLabel(&dao.SchemaField{Name: "mode", Type: "string"}))
+}
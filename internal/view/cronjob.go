@@ -3,12 +3,14 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/open-infra/osc/internal"
 	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/dao"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/open-infra/osc/internal/ui"
+	"github.com/open-infra/osc/internal/ui/dialog"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rs/zerolog/log"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -65,11 +67,17 @@ func (c *CronJob) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
 	})
+	if c.App().Config.Osc.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
+	})
 }
 
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
-	sel := c.GetTable().GetSelectedItem()
-	if sel == "" {
+	paths := c.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return evt
 	}
 
@@ -77,17 +85,59 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	if err != nil {
 		return nil
 	}
-	runner, ok := res.(dao.Runnable)
+	runner, ok := res.(dao.OverridableRunner)
 	if !ok {
 		c.App().Flash().Err(fmt.Errorf("expecting a jobrunner resource for %q", c.GVR()))
 		return nil
 	}
 
-	if err := runner.Run(sel); err != nil {
-		c.App().Flash().Errf("Cronjob trigger failed %v", err)
+	var (
+		spec dialog.RunSpec
+		dcj  dao.CronJob
+	)
+	if len(paths) == 1 {
+		if cj, err := dcj.Load(c.App().factory, paths[0]); err == nil {
+			if cc := cj.Spec.JobTemplate.Spec.Template.Spec.Containers; len(cc) > 0 {
+				spec.Container, spec.Args = cc[0].Name, strings.Join(cc[0].Args, " ")
+			}
+		}
+	}
+	dialog.ShowRun(c.App().Styles.Dialog(), c.App().Content.Pages, bulkMsg("Trigger", "cronjob", paths), spec, func(s dialog.RunSpec) {
+		args := s.Args
+		if args == spec.Args {
+			args = ""
+		}
+		oo, err := dao.ParseJobOverrides(s.Container, s.Env, args)
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		runBulk(c, "Trigger", paths, func(_ context.Context, path string) error {
+			return runner.RunWith(path, oo)
+		})
+	}, func() {})
+
+	return nil
+}
+
+func (c *CronJob) toggleSuspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := c.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return evt
 	}
-	c.App().Flash().Infof("Triggering Job %s %s", c.GVR(), sel)
+
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil
+	}
+	suspender, ok := res.(dao.Suspendable)
+	if !ok {
+		c.App().Flash().Err(fmt.Errorf("expecting a suspendable resource for %q", c.GVR()))
+		return nil
+	}
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Suspend/Resume", bulkMsg("Suspend/Resume", "cronjob", paths), func() {
+		runBulk(c, "Suspend/Resume", paths, suspender.ToggleSuspend)
+	}, func() {})
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/atotto/clipboard"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/config"
 	"github.com/open-infra/osc/internal/model"
 	"github.com/open-infra/osc/internal/ui"
@@ -27,8 +28,10 @@ type Details struct {
 	cmdBuff                   *model.FishBuff
 	model                     *model.Text
 	currentRegion, maxRegions int
+	matchLines                []int
 	searchable                bool
 	fullScreen                bool
+	explainGVR                *client.GVR
 }
 
 // NewDetails returns a details viewer.
@@ -83,13 +86,14 @@ func (d *Details) TextChanged(lines []string) {
 
 // TextFiltered notifies when the filter changed.
 func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
-	d.currentRegion, d.maxRegions = 0, 0
+	d.currentRegion, d.maxRegions, d.matchLines = 0, 0, d.matchLines[:0]
 
 	ll := make([]string, len(lines))
 	copy(ll, lines)
 	for _, m := range matches {
 		loc, line := m.MatchedIndexes, ll[m.Index]
 		ll[m.Index] = line[:loc[0]] + fmt.Sprintf(`<<<"search_%d">>>`, d.maxRegions) + line[loc[0]:loc[1]] + `<<<"">>>` + line[loc[1]:]
+		d.matchLines = append(d.matchLines, m.Index)
 		d.maxRegions++
 	}
 
@@ -131,6 +135,11 @@ func (d *Details) bindKeys() {
 	if !d.searchable {
 		d.actions.Delete(ui.KeyN, ui.KeyShiftN)
 	}
+	if d.explainGVR != nil {
+		d.actions.Add(ui.KeyActions{
+			ui.KeyX: ui.NewKeyAction("Explain", d.explainCmd, true),
+		})
+	}
 }
 
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -155,6 +164,12 @@ func (d *Details) Update(buff string) *Details {
 	return d
 }
 
+// SetExplainGVR enables explaining the yaml fields of the given resource.
+func (d *Details) SetExplainGVR(gvr client.GVR) *Details {
+	d.explainGVR = &gvr
+	return d
+}
+
 // SetSubject updates the subject.
 func (d *Details) SetSubject(s string) {
 	d.subject = s
@@ -202,6 +217,16 @@ func (d *Details) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Details) explainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.app.InCmdMode() {
+		return evt
+	}
+	row, _ := d.text.GetScrollOffset()
+	explainLine(d.app, *d.explainGVR, d.model.Peek(), selectedLine(row, d.matchLines, d.currentRegion))
+
+	return nil
+}
+
 func (d *Details) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if d.app.InCmdMode() {
 		return evt
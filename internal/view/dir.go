@@ -15,6 +15,7 @@ import (
 	"github.com/open-infra/osc/internal/render"
 	"github.com/open-infra/osc/internal/ui"
 	"github.com/open-infra/osc/internal/ui/dialog"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
 )
 
 const (
@@ -65,6 +66,7 @@ func (d *Dir) bindDangerousKeys(aa ui.KeyActions) {
 		ui.KeyA: ui.NewKeyAction("Apply", d.applyCmd, true),
 		ui.KeyD: ui.NewKeyAction("Delete", d.delCmd, true),
 		ui.KeyE: ui.NewKeyAction("Edit", d.editCmd, true),
+		ui.KeyI: ui.NewKeyAction("Import Kubeconfig", d.importCmd, true),
 	})
 }
 
@@ -254,6 +256,48 @@ func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Dir) importCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	if err := checkKubeConfig(sel); err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Merge kubeconfig %s into your current configuration?", sel)
+	dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Import Kubeconfig", msg, func() {
+		kc, err := kubeConfigurable(d.App())
+		if err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		nn, err := kc.Import(sel)
+		if err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.App().Flash().Infof("Imported contexts %s", strings.Join(nn, ", "))
+	}, func() {})
+
+	return nil
+}
+
+// checkKubeConfig ensures a given file is a kubeconfig defining contexts.
+func checkKubeConfig(path string) error {
+	cfg, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid kubeconfig: %w", path, err)
+	}
+	if len(cfg.Contexts) == 0 {
+		return fmt.Errorf("no contexts found in %s", path)
+	}
+
+	return nil
+}
+
 func fmtResults(res string) string {
 	res = strings.TrimSpace(res)
 	lines := strings.Split(res, "\n")
@@ -24,6 +24,24 @@ func TestIsManifest(t *testing.T) {
 	}
 }
 
+func TestCheckKubeConfig(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    bool
+	}{
+		"noExt":    {path: "testdata/kubeconfig/config", e: true},
+		"manifest": {path: "testdata/fred/kmanifests/cm.yaml"},
+		"missing":  {path: "testdata/kubeconfig/bozo"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, checkKubeConfig(u.path) == nil)
+		})
+	}
+}
+
 func TestIsKustomized(t *testing.T) {
 	uu := map[string]struct {
 		path string
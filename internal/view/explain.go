@@ -0,0 +1,354 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const (
+	explainTitle    = "Explain"
+	explainTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+)
+
+var yamlKeyRX = regexp.MustCompile(`^(\s*)(- )?("[^"]+"|'[^']+'|[^\s:#'"-][^:#]*?):(\s|$)`)
+
+// Explain represents a resource schema explainer.
+type Explain struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	path     string
+	schema   *SchemaTree
+	cmdBuff  *model.FishBuff
+	actions  ui.KeyActions
+	match    string
+	cancelFn context.CancelFunc
+}
+
+// NewExplain returns a new explainer for a resource and an optional field path.
+func NewExplain(gvr client.GVR, path string) *Explain {
+	return &Explain{
+		Flex:    tview.NewFlex(),
+		gvr:     gvr,
+		path:    path,
+		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the view.
+func (e *Explain) Init(ctx context.Context) error {
+	var err error
+	if e.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	e.SetBorder(true)
+	e.SetBorderPadding(0, 0, 1, 1)
+	e.schema = NewSchemaTree(e.app.Styles)
+	e.AddItem(e.schema, 0, 1, true)
+
+	e.app.Prompt().SetModel(e.cmdBuff)
+	e.cmdBuff.AddListener(e)
+
+	e.bindKeys()
+	e.SetInputCapture(e.keyboard)
+	e.app.Styles.AddListener(e)
+	e.StylesChanged(e.app.Styles)
+
+	return nil
+}
+
+// Name returns the component name.
+func (e *Explain) Name() string {
+	return explainTitle
+}
+
+// Start loads the resource schema.
+func (e *Explain) Start() {
+	e.cancel()
+
+	var ctx context.Context
+	ctx, e.cancelFn = context.WithCancel(context.Background())
+	go e.load(ctx)
+}
+
+// Stop cancels any pending loads.
+func (e *Explain) Stop() {
+	e.cancel()
+	e.app.Styles.RemoveListener(e)
+}
+
+func (e *Explain) cancel() {
+	if e.cancelFn == nil {
+		return
+	}
+	e.cancelFn()
+	e.cancelFn = nil
+}
+
+// Actions returns active menu bindings.
+func (e *Explain) Actions() ui.KeyActions {
+	return e.actions
+}
+
+// Hints returns the view hints.
+func (e *Explain) Hints() model.MenuHints {
+	return e.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (e *Explain) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (e *Explain) StylesChanged(s *config.Styles) {
+	e.SetBackgroundColor(s.BgColor())
+	e.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	e.schema.StylesChanged(s)
+	e.updateTitle()
+}
+
+// BufferChanged indicates the buffer was changed.
+func (e *Explain) BufferChanged(s string) {}
+
+// BufferCompleted indicates input was accepted.
+func (e *Explain) BufferCompleted(s string) {
+	e.search(s)
+}
+
+// BufferActive indicates the buff activity changed.
+func (e *Explain) BufferActive(state bool, k model.BufferKind) {
+	e.app.BufferActive(state, k)
+}
+
+func (e *Explain) load(ctx context.Context) {
+	root, err := dao.Explain(e.app.factory, e.gvr)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		e.app.Flash().Err(err)
+		return
+	}
+	e.app.QueueUpdateDraw(func() {
+		e.schema.SetRoot(root)
+		if path := explainPath(root, e.path); path != "" {
+			e.schema.Select(path)
+		}
+	})
+}
+
+func (e *Explain) bindKeys() {
+	e.actions.Add(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", e.filterCmd, false),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.resetCmd, false),
+		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", e.activateCmd, false),
+		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", e.eraseCmd, false),
+		ui.KeyN:         ui.NewKeyAction("Next Match", e.nextCmd, true),
+		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", e.prevCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Expand/Collapse All", e.toggleAllCmd, true),
+	})
+}
+
+func (e *Explain) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := e.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (e *Explain) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !e.cmdBuff.IsActive() {
+		return evt
+	}
+	e.cmdBuff.SetActive(false)
+	e.search(e.cmdBuff.GetText())
+
+	return nil
+}
+
+func (e *Explain) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.app.InCmdMode() {
+		return evt
+	}
+	e.app.ResetPrompt(e.cmdBuff)
+
+	return nil
+}
+
+func (e *Explain) eraseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !e.cmdBuff.IsActive() {
+		return nil
+	}
+	e.cmdBuff.Delete()
+
+	return nil
+}
+
+func (e *Explain) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !e.cmdBuff.InCmdMode() {
+		e.cmdBuff.Reset()
+		return e.app.PrevCmd(evt)
+	}
+	e.cmdBuff.SetActive(false)
+	e.cmdBuff.Reset()
+	e.search("")
+
+	return nil
+}
+
+func (e *Explain) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.cmdBuff.Empty() {
+		return evt
+	}
+	e.setMatch(e.schema.NextMatch(1))
+
+	return nil
+}
+
+func (e *Explain) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.cmdBuff.Empty() {
+		return evt
+	}
+	e.setMatch(e.schema.NextMatch(-1))
+
+	return nil
+}
+
+func (e *Explain) toggleAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.app.InCmdMode() {
+		return evt
+	}
+	e.schema.ToggleAll()
+
+	return nil
+}
+
+func (e *Explain) search(q string) {
+	if n := e.schema.Search(q); n > 0 {
+		e.setMatch(1, n)
+		return
+	}
+	e.setMatch(0, 0)
+}
+
+func (e *Explain) setMatch(current, total int) {
+	e.match = ""
+	if total > 0 {
+		e.match = fmt.Sprintf("[%d:%d]", current, total)
+	}
+	e.updateTitle()
+}
+
+func (e *Explain) updateTitle() {
+	fmat := fmt.Sprintf(explainTitleFmt, explainTitle, e.gvr.R())
+	if buff := e.cmdBuff.GetText(); buff != "" {
+		fmat += fmt.Sprintf(ui.SearchFmt, buff+e.match)
+	}
+	e.SetTitle(ui.SkinTitle(fmat, e.app.Styles.Frame()))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// gvrFunc resolves a command alias to a resource.
+type gvrFunc func(string) (client.GVR, bool)
+
+// parseExplain parses an explain command argument RESOURCE[.FIELD.PATH] into
+// a resource and a field path.
+func parseExplain(arg string, f gvrFunc) (client.GVR, string, error) {
+	if arg == "" {
+		return client.GVR{}, "", errors.New("usage: explain RESOURCE[.FIELD.PATH]")
+	}
+	tokens := strings.Split(arg, ".")
+	for i := len(tokens); i > 0; i-- {
+		if gvr, ok := f(strings.Join(tokens[:i], ".")); ok {
+			return gvr, strings.Join(tokens[i:], "."), nil
+		}
+	}
+
+	return client.GVR{}, "", fmt.Errorf("no resource found for %q", arg)
+}
+
+// explainPath returns the longest prefix of a field path found in a schema.
+func explainPath(root *dao.SchemaField, path string) string {
+	tokens := strings.Split(path, ".")
+	for i := len(tokens); i > 0; i-- {
+		p := strings.Join(tokens[:i], ".")
+		if _, ok := root.Find(p); ok {
+			return p
+		}
+	}
+
+	return ""
+}
+
+// yamlFieldPath returns the dotted field path of the key on the given yaml
+// line or blank if the line holds no key.
+func yamlFieldPath(lines []string, idx int) string {
+	if idx < 0 || idx >= len(lines) {
+		return ""
+	}
+	indent, key, ok := yamlKey(lines[idx])
+	if !ok {
+		return ""
+	}
+	path := []string{key}
+	for i := idx - 1; i >= 0 && indent > 0; i-- {
+		in, k, ok := yamlKey(lines[i])
+		if !ok || in >= indent {
+			continue
+		}
+		path, indent = append([]string{k}, path...), in
+	}
+
+	return strings.Join(path, ".")
+}
+
+// yamlKey returns a yaml line key and its indentation. List items keys are
+// indented past the dash.
+func yamlKey(line string) (int, string, bool) {
+	mm := yamlKeyRX.FindStringSubmatch(line)
+	if mm == nil {
+		return 0, "", false
+	}
+
+	return len(mm[1]) + len(mm[2]), strings.Trim(mm[3], `"'`), true
+}
+
+// selectedLine returns the current search match line if any or the top
+// visible line otherwise.
+func selectedLine(top int, matches []int, current int) int {
+	if current >= 0 && current < len(matches) {
+		return matches[current]
+	}
+
+	return top
+}
+
+// explainLine explains the yaml field on the given line.
+func explainLine(app *App, gvr client.GVR, lines []string, idx int) {
+	path := yamlFieldPath(lines, idx)
+	if path == "" {
+		app.Flash().Warn("No field found on the selected line")
+		return
+	}
+	if err := app.inject(NewExplain(gvr, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
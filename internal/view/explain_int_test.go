@@ -0,0 +1,130 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExplain(t *testing.T) {
+	aliases := map[string]string{
+		"po":               "v1/pods",
+		"deployments.apps": "apps/v1/deployments",
+	}
+	f := func(s string) (client.GVR, bool) {
+		gvr, ok := aliases[s]
+		return client.NewGVR(gvr), ok
+	}
+
+	uu := map[string]struct {
+		arg, gvr, path string
+		err            bool
+	}{
+		"resource":   {arg: "po", gvr: "v1/pods"},
+		"field":      {arg: "po.spec.containers", gvr: "v1/pods", path: "spec.containers"},
+		"group":      {arg: "deployments.apps.spec", gvr: "apps/v1/deployments", path: "spec"},
+		"unknown":    {arg: "blee.spec", err: true},
+		"noResource": {err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, path, err := parseExplain(u.arg, f)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, u.gvr, gvr.String())
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+const explainYAML = `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    app: fred
+  name: fred
+spec:
+  containers:
+  - image: nginx
+    name: nginx
+    ports:
+    - containerPort: 80
+      protocol: TCP
+  - "name": blee
+status:
+  phase: Running`
+
+func TestYAMLFieldPath(t *testing.T) {
+	lines := strings.Split(explainYAML, "\n")
+
+	uu := map[int]string{
+		0:  "apiVersion",
+		4:  "metadata.labels.app",
+		5:  "metadata.name",
+		7:  "spec.containers",
+		8:  "spec.containers.image",
+		9:  "spec.containers.name",
+		11: "spec.containers.ports.containerPort",
+		12: "spec.containers.ports.protocol",
+		13: "spec.containers.name",
+		15: "status.phase",
+		-1: "",
+		99: "",
+	}
+	for idx, e := range uu {
+		assert.Equal(t, e, yamlFieldPath(lines, idx), "line %d", idx)
+	}
+	assert.Equal(t, "", yamlFieldPath([]string{"  - nginx"}, 0))
+}
+
+func TestExplainPath(t *testing.T) {
+	root := &dao.SchemaField{Fields: []*dao.SchemaField{
+		{Name: "metadata", Path: "metadata", Fields: []*dao.SchemaField{
+			{Name: "labels", Path: "metadata.labels"},
+		}},
+	}}
+
+	assert.Equal(t, "metadata.labels", explainPath(root, "metadata.labels.app"))
+	assert.Equal(t, "metadata", explainPath(root, "metadata"))
+	assert.Equal(t, "", explainPath(root, "spec.containers"))
+}
+
+func TestSelectedLine(t *testing.T) {
+	assert.Equal(t, 3, selectedLine(3, nil, 0))
+	assert.Equal(t, 7, selectedLine(3, []int{5, 7}, 1))
+	assert.Equal(t, 3, selectedLine(3, []int{5, 7}, 2))
+}
+
+func TestSchemaTreeSearch(t *testing.T) {
+	s := NewSchemaTree(config.NewStyles())
+	s.SetRoot(&dao.SchemaField{Name: "Pod", Fields: []*dao.SchemaField{
+		{Name: "metadata", Path: "metadata", Fields: []*dao.SchemaField{
+			{Name: "name", Path: "metadata.name"},
+		}},
+		{Name: "spec", Path: "spec", Fields: []*dao.SchemaField{
+			{Name: "nodeName", Path: "spec.nodeName"},
+		}},
+	}})
+
+	assert.Equal(t, 2, s.Search("name"))
+	assert.Equal(t, "metadata.name", s.SelectedField().Path)
+	cur, total := s.NextMatch(1)
+	assert.Equal(t, 2, cur)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, "spec.nodeName", s.SelectedField().Path)
+	cur, _ = s.NextMatch(1)
+	assert.Equal(t, 1, cur)
+
+	assert.Equal(t, 0, s.Search("blee"))
+	assert.True(t, s.Select("spec.nodeName"))
+	assert.Equal(t, "spec.nodeName", s.SelectedField().Path)
+	assert.False(t, s.Select("spec.blee"))
+}
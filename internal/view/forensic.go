@@ -0,0 +1,98 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+// Forensic represents a pod restarts forensics viewer.
+type Forensic struct {
+	ResourceViewer
+}
+
+// NewForensic returns a new viewer.
+func NewForensic(gvr client.GVR) ResourceViewer {
+	f := Forensic{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.AddBindKeysFn(f.bindKeys)
+	f.GetTable().SetColorerFn(render.Forensic{}.ColorerFunc())
+	f.GetTable().SetSortCol(ageCol, true)
+	f.GetTable().SetEnterFn(f.showPrevLogs)
+
+	return &f
+}
+
+func (f *Forensic) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Logs", f.logsCmd(false), true),
+		ui.KeyP:      ui.NewKeyAction("Logs Previous", f.logsCmd(true), true),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Container", f.GetTable().SortColCmd("CONTAINER", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", f.GetTable().SortColCmd("REASON", true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", f.GetTable().SortColCmd("TYPE", true), false),
+	})
+}
+
+func (f *Forensic) showPrevLogs(app *App, _ ui.Tabular, _, path string) {
+	f.showLogs(true)
+}
+
+func (f *Forensic) logsCmd(prev bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if f.GetTable().GetSelectedItem() == "" {
+			return evt
+		}
+		f.showLogs(prev)
+
+		return nil
+	}
+}
+
+func (f *Forensic) showLogs(prev bool) {
+	co := f.selectedContainer()
+	if co == "" {
+		f.App().Flash().Warn("No container associated with this record")
+		return
+	}
+	if err := f.App().inject(NewLog(f.GVR(), f.GetTable().Path, co, prev)); err != nil {
+		f.App().Flash().Err(err)
+	}
+}
+
+func (f *Forensic) selectedContainer() string {
+	row, ok := f.GetTable().GetSelectedRow(f.GetTable().GetSelectedItem())
+	if !ok {
+		return ""
+	}
+	data := f.GetTable().GetModel().Peek()
+	col := data.IndexOfHeader("CONTAINER")
+	if col < 0 || col >= len(row.Fields) {
+		return ""
+	}
+	co := strings.TrimSpace(row.Fields[col])
+	if co == render.MissingValue {
+		return ""
+	}
+
+	return co
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showForensics(app *App, path string) {
+	v := NewForensic(client.NewGVR("forensics"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
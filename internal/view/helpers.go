@@ -182,27 +182,32 @@ func decorateCpuMemHeaderRows(app *App, data render.TableData) render.TableData
 		if len(check) == 0 {
 			continue
 		}
-		for _, re := range data.RowEvents {
-			if re.Row.Fields[colIndex] == render.NAValue {
-				continue
-			}
-			n, err := strconv.Atoi(re.Row.Fields[colIndex])
-			if err != nil {
-				continue
-			}
-			if n > 100 {
-				n = 100
-			}
-			severity := app.Config.Osc.Thresholds.LevelFor(check, n)
-			if severity == config.SeverityLow {
-				continue
-			}
-			color := app.Config.Osc.Thresholds.SeverityColor(check, n)
-			if len(color) > 0 {
-				re.Row.Fields[colIndex] = "[" + color + "::b]" + re.Row.Fields[colIndex]
-			}
-		}
+		colorSeverity(app, data, colIndex, check)
 	}
 
 	return data
 }
+
+// colorSeverity colors a percentage column based on the given threshold.
+func colorSeverity(app *App, data render.TableData, colIndex int, check string) {
+	for _, re := range data.RowEvents {
+		if re.Row.Fields[colIndex] == render.NAValue {
+			continue
+		}
+		n, err := strconv.Atoi(re.Row.Fields[colIndex])
+		if err != nil {
+			continue
+		}
+		if n > 100 {
+			n = 100
+		}
+		severity := app.Config.Osc.Thresholds.LevelFor(check, n)
+		if severity == config.SeverityLow {
+			continue
+		}
+		color := app.Config.Osc.Thresholds.SeverityColor(check, n)
+		if len(color) > 0 {
+			re.Row.Fields[colIndex] = "[" + color + "::b]" + re.Row.Fields[colIndex]
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/tchart"
+	"github.com/open-infra/osc/internal/ui"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	hpaTitle       = "HPA"
+	hpaReplicasID  = "replicas"
+	hpaChartsSize  = 10
+	hpaMaxMessages = 80
+)
+
+// HPA represents an HPA scaling behavior viewer.
+type HPA struct {
+	*tview.Flex
+
+	app      *App
+	path     string
+	model    *model.HPA
+	charts   *tview.Flex
+	sparks   []*tchart.SparkLine
+	details  *tview.TextView
+	actions  ui.KeyActions
+	cancelFn context.CancelFunc
+}
+
+// NewHPA returns a new HPA viewer for a given context.
+func NewHPA(contextName, path string) *HPA {
+	return &HPA{
+		Flex:    tview.NewFlex(),
+		path:    path,
+		model:   model.NewHPA(contextName, path),
+		charts:  tview.NewFlex(),
+		details: tview.NewTextView(),
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the view.
+func (h *HPA) Init(ctx context.Context) error {
+	var err error
+	if h.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	h.SetBorder(true)
+	h.SetTitle(fmt.Sprintf(" %s([::b]%s[::-]) ", hpaTitle, h.path))
+	h.SetBorderPadding(0, 0, 1, 1)
+	h.SetDirection(tview.FlexRow)
+	h.details.SetDynamicColors(true)
+	h.details.SetScrollable(true).SetWrap(false)
+	h.AddItem(h.charts, hpaChartsSize, 0, false)
+	h.AddItem(h.details, 0, 1, true)
+
+	h.bindKeys()
+	h.SetInputCapture(h.keyboard)
+	h.model.AddListener(h)
+	h.app.Styles.AddListener(h)
+	h.StylesChanged(h.app.Styles)
+	h.resetCharts(h.model.Samples())
+
+	return nil
+}
+
+// Name returns the component name.
+func (h *HPA) Name() string {
+	return hpaTitle
+}
+
+// Start initializes the HPA watch loop.
+func (h *HPA) Start() {
+	h.cancel()
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, h.app.factory)
+	ctx, h.cancelFn = context.WithCancel(ctx)
+	h.model.Watch(ctx)
+}
+
+// Stop terminates the HPA watch loop.
+func (h *HPA) Stop() {
+	h.cancel()
+	h.app.Styles.RemoveListener(h)
+}
+
+// Actions returns active menu bindings.
+func (h *HPA) Actions() ui.KeyActions {
+	return h.actions
+}
+
+// Hints returns the view hints.
+func (h *HPA) Hints() model.MenuHints {
+	return h.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (h *HPA) ExtraHints() map[string]string {
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (h *HPA) StylesChanged(s *config.Styles) {
+	h.SetBackgroundColor(s.BgColor())
+	h.charts.SetBackgroundColor(s.Charts().BgColor.Color())
+	h.details.SetBackgroundColor(s.BgColor())
+	h.details.SetTextColor(s.FgColor())
+	h.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	for _, sp := range h.sparks {
+		h.styleSpark(sp, s)
+	}
+}
+
+// HPAChanged notifies the HPA state changed.
+func (h *HPA) HPAChanged(st *dao.HPAStatus, ss []model.HPASample) {
+	h.app.QueueUpdateDraw(func() {
+		if len(ss) == 0 {
+			return
+		}
+		if !h.sameCharts(ss[len(ss)-1]) {
+			h.resetCharts(ss)
+		} else {
+			h.addSample(ss[len(ss)-1])
+		}
+		h.details.SetText(hpaDetails(st, h.app.Styles, time.Now()))
+	})
+}
+
+// HPAFailed notifies the HPA load failed.
+func (h *HPA) HPAFailed(err error) {
+	h.app.Flash().Err(err)
+}
+
+func (h *HPA) bindKeys() {
+	h.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+	})
+}
+
+func (h *HPA) cancel() {
+	if h.cancelFn == nil {
+		return
+	}
+	h.cancelFn()
+	h.cancelFn = nil
+}
+
+func (h *HPA) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := h.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (h *HPA) sameCharts(s model.HPASample) bool {
+	if len(h.sparks) != len(s.Metrics)+1 {
+		return false
+	}
+	for i, m := range s.Metrics {
+		if h.sparks[i+1].ID() != m.Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resetCharts lays out a replicas chart and a chart per metric and replays
+// the session samples.
+func (h *HPA) resetCharts(ss []model.HPASample) {
+	h.charts.Clear()
+	h.sparks = h.sparks[:0]
+	if len(ss) == 0 {
+		return
+	}
+
+	h.sparks = append(h.sparks, h.makeSpark(hpaReplicasID, "current", "desired"))
+	for _, m := range ss[len(ss)-1].Metrics {
+		h.sparks = append(h.sparks, h.makeSpark(m.Name, "current", "target"))
+	}
+	for _, s := range ss {
+		h.addSample(s)
+	}
+}
+
+func (h *HPA) makeSpark(id, s1, s2 string) *tchart.SparkLine {
+	sp := tchart.NewSparkLine(id)
+	sp.SetBorderPadding(0, 1, 0, 1)
+	sp.SetMultiSeries(true)
+	sp.SetSeriesLabels(s1, s2)
+	sp.SetStatic(h.app.Config.Osc.IsAccessible())
+	sp.SetLegend(fmt.Sprintf(" %s ", id))
+	h.styleSpark(sp, h.app.Styles)
+	h.charts.AddItem(sp, 0, 1, false)
+
+	return sp
+}
+
+func (h *HPA) styleSpark(sp *tchart.SparkLine, s *config.Styles) {
+	sp.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+	sp.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+	if cc, ok := s.Charts().ResourceColors[sp.ID()]; ok {
+		sp.SetSeriesColors(cc.Colors()...)
+	}
+}
+
+func (h *HPA) addSample(s model.HPASample) {
+	for _, sp := range h.sparks {
+		if sp.ID() == hpaReplicasID {
+			sp.SetLegend(fmt.Sprintf(" Replicas %d/%d ", s.Replicas, s.Desired))
+			sp.Add(tchart.Metric{S1: int64(s.Replicas), S2: int64(s.Desired)})
+			continue
+		}
+		for _, m := range s.Metrics {
+			if m.Name != sp.ID() {
+				continue
+			}
+			cur := render.NAValue
+			if m.Known {
+				cur = m.FormatValue(m.Current)
+			}
+			sp.SetLegend(fmt.Sprintf(" %s %s/%s ", m.Name, cur, m.FormatValue(m.Target)))
+			sp.Add(tchart.Metric{S1: m.Current, S2: m.Target})
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showHPA(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewHPA(app.Config.Osc.CurrentContext, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func hpaDetails(st *dao.HPAStatus, s *config.Styles, now time.Time) string {
+	section, fg := s.K9s.Info.SectionColor.String(), s.Body().FgColor.String()
+	hilite, errColor := s.Frame().Status.HighlightColor.String(), s.Frame().Status.ErrorColor.String()
+	recommended, driver := st.Recommended()
+
+	var b strings.Builder
+	field := func(k, v string) {
+		fmt.Fprintf(&b, "[%s::b]%-12s[%s::-]%s\n", section, k+":", fg, v)
+	}
+	field("Reference", st.Reference)
+	field("Replicas", fmt.Sprintf("current=%d desired=%d recommended=%d min=%d max=%d",
+		st.CurrentReplicas, st.DesiredReplicas, recommended, st.MinReplicas, st.MaxReplicas))
+	field("Driver", orNA(driver))
+	field("Last Scale", hpaAge(st.LastScale, now))
+	for _, r := range st.Behavior {
+		field("Behavior", r)
+	}
+
+	rows := [][]string{{"NAME", "TYPE", "CURRENT", "TARGET", "DESIRED"}}
+	colors := []string{section}
+	for _, m := range st.Metrics {
+		cur, desired, color := render.NAValue, render.NAValue, fg
+		if m.Known {
+			cur, desired = m.FormatValue(m.Current), strconv.Itoa(int(m.Desired))
+		}
+		if m.Name == driver {
+			color = hilite
+		}
+		rows = append(rows, []string{m.Name, m.Type, cur, m.FormatValue(m.Target), desired})
+		colors = append(colors, color)
+	}
+	hpaSection(&b, "METRICS", section, rows, colors)
+
+	rows, colors = [][]string{{"TYPE", "STATUS", "REASON", "AGE", "MESSAGE"}}, []string{section}
+	for _, c := range st.Conditions {
+		color := fg
+		if !hpaConditionOK(c) {
+			color = errColor
+		}
+		rows = append(rows, []string{c.Type, c.Status, c.Reason, hpaAge(c.LastTransition, now), c.Message})
+		colors = append(colors, color)
+	}
+	hpaSection(&b, "CONDITIONS", section, rows, colors)
+
+	rows, colors = [][]string{{"AGE", "TYPE", "REASON", "COUNT", "MESSAGE"}}, []string{section}
+	for _, e := range st.Events {
+		color := fg
+		if e.Type != "Normal" {
+			color = errColor
+		}
+		rows = append(rows, []string{hpaAge(e.At, now), e.Type, e.Reason, strconv.Itoa(int(e.Count)), render.Truncate(e.Message, hpaMaxMessages)})
+		colors = append(colors, color)
+	}
+	hpaSection(&b, "EVENTS", section, rows, colors)
+
+	return b.String()
+}
+
+func hpaSection(b *strings.Builder, title, color string, rows [][]string, colors []string) {
+	fmt.Fprintf(b, "\n[%s::b]%s[-::-]\n", color, title)
+	if len(rows) == 1 {
+		fmt.Fprintf(b, "  %s\n", render.NAValue)
+		return
+	}
+
+	widths := make([]int, len(rows[0]))
+	for _, r := range rows {
+		for i, c := range r {
+			if len(c) > widths[i] {
+				widths[i] = len(c)
+			}
+		}
+	}
+	for i, r := range rows {
+		cells := make([]string, len(r))
+		for j, c := range r {
+			cells[j] = tview.Escape(render.Pad(c, widths[j]))
+		}
+		fmt.Fprintf(b, "  [%s::]%s[-::]\n", colors[i], strings.TrimRight(strings.Join(cells, "  "), " "))
+	}
+}
+
+// hpaConditionOK returns true if a condition does not hinder scaling.
+func hpaConditionOK(c dao.HPACondition) bool {
+	if c.Type == "ScalingLimited" {
+		return c.Status != "True"
+	}
+
+	return c.Status == "True"
+}
+
+func hpaAge(t, now time.Time) string {
+	if t.IsZero() {
+		return render.NAValue
+	}
+
+	return duration.HumanDuration(now.Sub(t))
+}
+
+func orNA(s string) string {
+	if s == "" {
+		return render.NAValue
+	}
+
+	return s
+}
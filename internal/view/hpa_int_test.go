@@ -0,0 +1,68 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPADetails(t *testing.T) {
+	now := time.Now()
+	st := dao.HPAStatus{
+		Reference:       "Deployment/fred",
+		MinReplicas:     1,
+		MaxReplicas:     10,
+		CurrentReplicas: 2,
+		DesiredReplicas: 2,
+		LastScale:       now.Add(-2 * time.Minute),
+		Metrics: []dao.HPAMetric{
+			{Name: "cpu", Type: dao.HPAUtilization, Current: 40, Target: 50, Known: true, Desired: 2},
+			{Name: "pods/rps", Type: dao.HPAAverageValue, Current: 30000, Target: 10000, Known: true, Desired: 6},
+			{Name: "external/queue", Type: dao.HPAValue, Target: 5000},
+		},
+		Conditions: []dao.HPACondition{
+			{Type: "AbleToScale", Status: "True", Reason: "ReadyForNewScale"},
+			{Type: "ScalingLimited", Status: "True", Reason: "TooManyReplicas"},
+		},
+		Events: []dao.HPAEvent{
+			{Type: "Normal", Reason: "SuccessfulRescale", Message: "New size: 2", Count: 1, At: now.Add(-time.Minute)},
+		},
+	}
+
+	s := config.NewStyles()
+	d := hpaDetails(&st, s, now)
+	lines := strings.Split(d, "\n")
+
+	assert.Contains(t, d, "current=2 desired=2 recommended=6 min=1 max=10")
+	assert.Contains(t, d, "pods/rps")
+	assert.Contains(t, lines[2], "pods/rps")
+	assert.Contains(t, lines[3], "2m")
+	assert.Contains(t, d, "["+s.Frame().Status.HighlightColor.String()+"::]pods/rps")
+	assert.Contains(t, d, "["+s.Frame().Status.ErrorColor.String()+"::]ScalingLimited")
+	assert.Contains(t, d, "SuccessfulRescale")
+	assert.Contains(t, d, "external/queue  Value         n/a")
+}
+
+func TestHPAConditionOK(t *testing.T) {
+	uu := map[string]struct {
+		c dao.HPACondition
+		e bool
+	}{
+		"able":        {c: dao.HPACondition{Type: "AbleToScale", Status: "True"}, e: true},
+		"unable":      {c: dao.HPACondition{Type: "AbleToScale", Status: "False"}},
+		"inactive":    {c: dao.HPACondition{Type: "ScalingActive", Status: "Unknown"}},
+		"limited":     {c: dao.HPACondition{Type: "ScalingLimited", Status: "True"}},
+		"withinRange": {c: dao.HPACondition{Type: "ScalingLimited", Status: "False"}, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hpaConditionOK(u.c))
+		})
+	}
+}
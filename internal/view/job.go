@@ -1,15 +1,24 @@
 package view
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
 	"github.com/open-infra/osc/internal/render"
 	"github.com/open-infra/osc/internal/ui"
+	"github.com/open-infra/osc/internal/ui/dialog"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const defaultPruneAge = "24h"
+
 // Job represents a job viewer.
 type Job struct {
 	ResourceViewer
@@ -21,10 +30,76 @@ func NewJob(gvr client.GVR) ResourceViewer {
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetColorerFn(render.Job{}.ColorerFunc())
 	j.GetTable().SetSortCol("AGE", true)
+	j.AddBindKeysFn(j.bindKeys)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa ui.KeyActions) {
+	if j.App().Config.Osc.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlX: ui.NewKeyAction("Prune Finished", j.pruneCmd, true),
+	})
+}
+
+func (j *Job) pruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	data := j.GetTable().GetFilteredData()
+	paths := make([]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		paths = append(paths, re.Row.ID)
+	}
+	if len(paths) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Delete finished jobs older than (ie 30m, 24h) amongst %d listed jobs", len(paths))
+	dialog.ShowInput(j.App().Styles.Dialog(), j.App().Content.Pages, "Prune Finished", msg, "Older Than:", defaultPruneAge, func(s string) {
+		age, err := time.ParseDuration(s)
+		if err != nil {
+			j.App().Flash().Errf("invalid duration %q", s)
+			return
+		}
+		if age <= 0 {
+			j.App().Flash().Errf("prune age must be positive but got %q", s)
+			return
+		}
+		j.prune(paths, age)
+	}, func() {})
+
+	return nil
+}
+
+func (j *Job) prune(paths []string, age time.Duration) {
+	res, err := dao.AccessorFor(j.App().factory, j.GVR())
+	if err != nil {
+		j.App().Flash().Err(err)
+		return
+	}
+	pruner, ok := res.(dao.Pruner)
+	if !ok {
+		j.App().Flash().Err(fmt.Errorf("expecting a pruner resource for %q", j.GVR()))
+		return
+	}
+
+	victims, err := pruner.Prunable(paths, age)
+	if err != nil {
+		j.App().Flash().Err(err)
+		return
+	}
+	if len(victims) == 0 {
+		j.App().Flash().Infof("No finished jobs older than %s", age)
+		return
+	}
+
+	dialog.ShowConfirm(j.App().Styles.Dialog(), j.App().Content.Pages, "Prune Finished", bulkMsg("Delete", "job", victims), func() {
+		runBulk(j, "Prune", victims, func(_ context.Context, path string) error {
+			return pruner.Delete(path, true, false)
+		})
+	}, func() {})
+}
+
 func (*Job) showPods(app *App, model ui.Tabular, gvr, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
 	"github.com/open-infra/osc/internal/config"
 	"github.com/open-infra/osc/internal/model"
 	"github.com/open-infra/osc/internal/ui"
@@ -30,8 +31,10 @@ type LiveView struct {
 	cmdBuff                   *model.FishBuff
 	model                     model.ResourceViewer
 	currentRegion, maxRegions int
+	matchLines                []int
 	fullScreen                bool
 	managedField              bool
+	explainGVR                *client.GVR
 	cancel                    context.CancelFunc
 }
 
@@ -89,10 +92,11 @@ func (v *LiveView) ResourceFailed(err error) {
 func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 	v.app.QueueUpdateDraw(func() {
 		v.text.SetTextAlign(tview.AlignLeft)
-		v.maxRegions = len(matches)
+		v.maxRegions, v.matchLines = len(matches), v.matchLines[:0]
 		ll := make([]string, len(lines))
 		copy(ll, lines)
 		for i, m := range matches {
+			v.matchLines = append(v.matchLines, m.Index)
 			loc, line := m.MatchedIndexes, ll[m.Index]
 			ll[m.Index] = line[:loc[0]] + `<<<"search_` + strconv.Itoa(i) + `">>>` + line[loc[0]:loc[1]] + `<<<"">>>` + line[loc[1]:]
 		}
@@ -142,6 +146,11 @@ func (v *LiveView) bindKeys() {
 			ui.KeyM: ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true),
 		})
 	}
+	if v.explainGVR != nil {
+		v.actions.Add(ui.KeyActions{
+			ui.KeyX: ui.NewKeyAction("Explain", v.explainCmd, true),
+		})
+	}
 }
 
 func (v *LiveView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -201,6 +210,22 @@ func (v *LiveView) ExtraHints() map[string]string {
 	return nil
 }
 
+// SetExplainGVR enables explaining the yaml fields of the given resource.
+func (v *LiveView) SetExplainGVR(gvr client.GVR) *LiveView {
+	v.explainGVR = &gvr
+	return v
+}
+
+func (v *LiveView) explainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+	row, _ := v.text.GetScrollOffset()
+	explainLine(v.app, *v.explainGVR, v.model.Peek(), selectedLine(row, v.matchLines, v.currentRegion))
+
+	return nil
+}
+
 func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.app.InCmdMode() {
 		return evt
@@ -202,6 +202,7 @@ func (l *Log) bindKeys() {
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", l.cpCmd, true),
 	})
+	keymapActions(l.app, []string{"logs", "log"}, l.logs.Actions())
 }
 
 func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
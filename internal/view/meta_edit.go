@@ -0,0 +1,111 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/ui/dialog"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// editMeta pops a labels, annotations and taints editor for a given resource.
+func editMeta(v ResourceViewer, path string) {
+	o, err := v.App().factory.Get(v.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		v.App().Flash().Err(fmt.Errorf("expecting *unstructured.Unstructured but got %T", o))
+		return
+	}
+
+	spec := dialog.MetaSpec{
+		Labels:      dao.MetaRows(u.GetLabels()),
+		Annotations: dao.MetaRows(u.GetAnnotations()),
+	}
+	var taints []v1.Taint
+	if v.GVR() == client.NewGVR("v1/nodes") {
+		var no v1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		taints = no.Spec.Taints
+		spec.Taints, spec.WithTaints = dao.TaintRows(taints), true
+	}
+
+	msg := fmt.Sprintf("%s %s", v.GVR().R(), path)
+	dialog.ShowMeta(v.App().Styles.Dialog(), v.App().Content.Pages, msg, spec, func(s dialog.MetaSpec) {
+		if err := applyMeta(v, path, u, taints, s); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		v.App().Flash().Infof("Metadata updated for %s", path)
+		v.Refresh()
+	}, func() {})
+}
+
+// applyMeta patches the resource with the metadata changes if any.
+func applyMeta(v ResourceViewer, path string, u *unstructured.Unstructured, taints []v1.Taint, s dialog.MetaSpec) error {
+	ll, err := dao.ParseMetaRows(s.Labels)
+	if err != nil {
+		return err
+	}
+	aa, err := dao.ParseMetaRows(s.Annotations)
+	if err != nil {
+		return err
+	}
+	tt, err := dao.ParseTaints(s.Taints)
+	if err != nil {
+		return err
+	}
+	p := dao.MetaPatch{
+		Labels:      dao.DiffMeta(u.GetLabels(), ll),
+		Annotations: dao.DiffMeta(u.GetAnnotations(), aa),
+	}
+
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+	defer cancel()
+	if !p.IsEmpty() {
+		m, ok := res.(dao.MetaPatcher)
+		if !ok {
+			return fmt.Errorf("expecting a meta patcher for %q", v.GVR())
+		}
+		if err := m.PatchMeta(ctx, path, p); err != nil {
+			return err
+		}
+	}
+	if !s.WithTaints || sameRows(dao.TaintRows(taints), dao.TaintRows(tt)) {
+		return nil
+	}
+	tp, ok := res.(dao.TaintPatcher)
+	if !ok {
+		return fmt.Errorf("expecting a taint patcher for %q", v.GVR())
+	}
+
+	return tp.PatchTaints(ctx, path, tt)
+}
+
+func sameRows(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameRows(t *testing.T) {
+	assert.True(t, sameRows(nil, []string{}))
+	assert.True(t, sameRows([]string{"a:NoSchedule"}, []string{"a:NoSchedule"}))
+	assert.False(t, sameRows([]string{"a:NoSchedule"}, []string{"a:NoExecute"}))
+	assert.False(t, sameRows([]string{"a:NoSchedule"}, nil))
+}
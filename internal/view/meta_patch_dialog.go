@@ -0,0 +1,76 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const metaPatchKey = "metaPatch"
+
+// MetaPatchFunc represents a labels/annotations patch callback function.
+type MetaPatchFunc func(v ResourceViewer, paths []string, p dao.MetaPatch)
+
+// ShowMetaPatch pops a labels/annotations patch dialog. Updates use the kubectl
+// syntax ie `k1=v1,k2-` where a trailing dash removes a key.
+func ShowMetaPatch(view ResourceViewer, paths []string, okFn MetaPatchFunc) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	var labels, annotations string
+	f.AddInputField("Labels:", "", 40, nil, func(v string) {
+		labels = v
+	})
+	f.AddInputField("Annotations:", "", 40, nil, func(v string) {
+		annotations = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissMetaPatch(view, pages)
+	})
+	f.AddButton("OK", func() {
+		var (
+			p   dao.MetaPatch
+			err error
+		)
+		if p.Labels, err = dao.ParseMetaPatch(labels); err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		if p.Annotations, err = dao.ParseMetaPatch(annotations); err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		DismissMetaPatch(view, pages)
+		if p.IsEmpty() {
+			return
+		}
+		okFn(view, paths, p)
+	})
+
+	modal := tview.NewModalForm("<Label/Annotate>", f)
+	modal.SetText(bulkMsg("Patch", strings.TrimSuffix(view.GVR().R(), "s"), paths))
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissMetaPatch(view, pages)
+	})
+
+	pages.AddPage(metaPatchKey, modal, false, true)
+	pages.ShowPage(metaPatchKey)
+	view.App().SetFocus(pages.GetPrimitive(metaPatchKey))
+}
+
+// DismissMetaPatch dismiss the labels/annotations patch dialog.
+func DismissMetaPatch(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(metaPatchKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const netCheckSvcPrefix = "svc:"
+
+// NetCheck represents a network policies reachability viewer.
+type NetCheck struct {
+	ResourceViewer
+}
+
+// NewNetCheck returns a new viewer.
+func NewNetCheck(gvr client.GVR) ResourceViewer {
+	n := NetCheck{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetColorerFn(render.NetCheck{}.ColorerFunc())
+	n.GetTable().SetSortCol("DIRECTION", true)
+	n.GetTable().SetEnterFn(n.showPolicies)
+
+	return &n
+}
+
+func (n *NetCheck) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Direction", n.GetTable().SortColCmd("DIRECTION", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verdict", n.GetTable().SortColCmd("VERDICT", true), false),
+	})
+}
+
+// showPolicies navigates to the network policies governing the selected side.
+func (n *NetCheck) showPolicies(app *App, _ ui.Tabular, _, path string) {
+	row, ok := n.GetTable().GetSelectedRow(path)
+	if !ok {
+		return
+	}
+	data := n.GetTable().GetModel().Peek()
+	col := data.IndexOfHeader("POLICY")
+	if col < 0 || col >= len(row.Fields) {
+		return
+	}
+	pol := strings.Split(strings.TrimSpace(row.Fields[col]), ",")[0]
+	if pol == render.MissingValue {
+		app.Flash().Info("No network policies apply to this side")
+		return
+	}
+	ns, _ := client.Namespaced(pol)
+	if err := app.gotoResource("np "+ns, "", false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseNetCheck parses netcheck command arguments into a reachability spec.
+// Pods are given as ns/name or name in the active namespace. Services use a svc: prefix.
+func parseNetCheck(args []string, ns string) (dao.NetCheckSpec, error) {
+	var spec dao.NetCheckSpec
+	if len(args) < 2 || len(args) > 3 {
+		return spec, errors.New("usage: netcheck SRC_POD DST_POD|svc:DST_SVC [PORT]")
+	}
+
+	src, err := netCheckFQN(args[0], ns)
+	if err != nil {
+		return spec, err
+	}
+	dst := args[1]
+	if strings.HasPrefix(dst, netCheckSvcPrefix) {
+		spec.IsService, dst = true, strings.TrimPrefix(dst, netCheckSvcPrefix)
+	}
+	if dst, err = netCheckFQN(dst, ns); err != nil {
+		return spec, err
+	}
+	spec.Source, spec.Destination = src, dst
+	if len(args) == 3 {
+		spec.Port = args[2]
+	}
+
+	return spec, nil
+}
+
+func netCheckFQN(n, ns string) (string, error) {
+	if strings.Contains(n, "/") {
+		return n, nil
+	}
+	if !client.IsNamespaced(ns) {
+		return "", fmt.Errorf("a namespace is required for %q", n)
+	}
+
+	return client.FQN(ns, n), nil
+}
+
+func newNetCheckView(spec dao.NetCheckSpec) ResourceViewer {
+	v := NewNetCheck(client.NewGVR("netchecks"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyNetCheck, spec)
+	})
+
+	return v
+}
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNetCheck(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		ns   string
+		e    dao.NetCheckSpec
+		err  bool
+	}{
+		"pods": {
+			args: []string{"fe", "data/db", "5432"},
+			ns:   "default",
+			e:    dao.NetCheckSpec{Source: "default/fe", Destination: "data/db", Port: "5432"},
+		},
+		"service": {
+			args: []string{"default/fe", "svc:db"},
+			ns:   "data",
+			e:    dao.NetCheckSpec{Source: "default/fe", Destination: "data/db", IsService: true},
+		},
+		"all-ns": {
+			args: []string{"fe", "db"},
+			ns:   "all",
+			err:  true,
+		},
+		"missing-dst": {
+			args: []string{"fe"},
+			ns:   "default",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, err := parseNetCheck(u.args, u.ns)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec)
+		})
+	}
+}
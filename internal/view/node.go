@@ -47,14 +47,14 @@ func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
 }
 
 func (n *Node) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
-
 	if !n.App().Config.Osc.IsReadOnly() {
 		n.bindDangerousKeys(aa)
 	}
 
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.yamlCmd, true),
+		ui.KeyA:      ui.NewKeyAction("Allocations", n.allocCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Drain Blockers", n.drainBlockersCmd, true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 	})
@@ -64,6 +64,26 @@ func (n *Node) showPods(a *App, _ ui.Tabular, _, path string) {
 	showPods(a, n.GetTable().GetSelectedItem(), client.AllNamespaces, "spec.nodeName="+path)
 }
 
+func (n *Node) allocCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPodAllocs(n.App(), path)
+
+	return nil
+}
+
+func (n *Node) drainBlockersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDrainBlockers(n.App(), path)
+
+	return nil
+}
+
 func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := n.GetTable().GetSelectedItem()
 	if path == "" {
@@ -109,18 +129,17 @@ func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
 
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
-		path := n.GetTable().GetSelectedItem()
-		if path == "" {
+		paths := n.GetTable().GetSelectedItems()
+		if len(paths) == 0 {
 			return evt
 		}
 
-		title, msg := "Confirm ", ""
-		if cordon {
-			title, msg = title+"Cordon", "Cordon "
-		} else {
-			title, msg = title+"Uncordon", "Uncordon "
+		title, op := "Confirm ", "Cordon"
+		if !cordon {
+			op = "Uncordon"
 		}
-		msg += path + "?"
+		title += op
+		msg := bulkMsg(op, "node", paths)
 		dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, title, msg, func() {
 			res, err := dao.AccessorFor(n.App().factory, n.GVR())
 			if err != nil {
@@ -132,10 +151,9 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
-			if err := m.ToggleCordon(path, cordon); err != nil {
-				n.App().Flash().Err(err)
-			}
-			n.Refresh()
+			runBulk(n, op, paths, func(_ context.Context, path string) error {
+				return m.ToggleCordon(path, cordon)
+			})
 		}, func() {})
 
 		return nil
@@ -188,7 +206,7 @@ func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(n.App(), "YAML", sel, true).Update(raw)
+	details := NewDetails(n.App(), "YAML", sel, true).SetExplainGVR(n.GVR()).Update(raw)
 	if err := n.App().inject(details); err != nil {
 		n.App().Flash().Err(err)
 	}
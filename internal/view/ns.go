@@ -37,9 +37,22 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyQ: ui.NewKeyAction("Quotas", n.quotasCmd, true),
 	})
 }
 
+func (n *Namespace) quotasCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	if err := n.App().gotoResource("quotas "+path, "", false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Namespace) switchNs(app *App, model ui.Tabular, gvr, path string) {
 	n.useNamespace(path)
 	if err := app.gotoResource("pods", "", false); err != nil {
@@ -35,6 +35,7 @@ func (p *PageStack) Init(ctx context.Context) (err error) {
 func (p *PageStack) StackPushed(c model.Component) {
 	c.Start()
 	p.app.SetFocus(c)
+	p.app.Announce("Viewing " + c.Name())
 }
 
 // StackPopped notifies a page was removed.
@@ -50,4 +51,5 @@ func (p *PageStack) StackTop(top model.Component) {
 	}
 	top.Start()
 	p.app.SetFocus(top)
+	p.app.Announce("Viewing " + top.Name())
 }
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const pdbGVR = "policy/v1beta1/poddisruptionbudgets"
+
+// PodDisruptionBudget represents a pod disruption budget impact view.
+type PodDisruptionBudget struct {
+	ResourceViewer
+}
+
+// NewPodDisruptionBudget returns a new pod disruption budget view.
+func NewPodDisruptionBudget(gvr client.GVR) ResourceViewer {
+	p := PodDisruptionBudget{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.showPods)
+	p.GetTable().SetColorerFn(render.PodDisruptionBudget{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PodDisruptionBudget) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftB: ui.NewKeyAction("Sort Blocking", p.GetTable().SortColCmd("BLOCKING", false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", p.GetTable().SortColCmd("PODS", false), false),
+	})
+}
+
+func (p *PodDisruptionBudget) showPods(app *App, _ ui.Tabular, _, path string) {
+	var pdb dao.PodDisruptionBudget
+	o, err := pdb.Load(app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if o.Spec.Selector == nil {
+		app.Flash().Warnf("PDB %s does not select any pods", path)
+		return
+	}
+
+	showPodsFromSelector(app, path, o.Spec.Selector)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showDrainBlockers lists the budgets that would block draining a node.
+func showDrainBlockers(app *App, node string) {
+	if err := app.switchNS(client.AllNamespaces); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	v := NewPodDisruptionBudget(client.NewGVR(pdbGVR))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, node)
+		return context.WithValue(ctx, internal.KeyFields, "spec.nodeName="+node)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,152 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/rs/zerolog/log"
+)
+
+// PluginTable represents a plugin table output viewer.
+type PluginTable struct {
+	ResourceViewer
+
+	refreshRate time.Duration
+}
+
+// NewPluginTable returns a new viewer.
+func NewPluginTable(gvr client.GVR) ResourceViewer {
+	p := PluginTable{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetEnterFn(p.gotoCmd)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *PluginTable) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetRefreshRate(p.refreshRate)
+
+	return nil
+}
+
+// gotoCmd chains to the view command specified by the selected row if any.
+func (p *PluginTable) gotoCmd(app *App, _ ui.Tabular, _, path string) {
+	row, ok := p.GetTable().GetSelectedRow(path)
+	if !ok {
+		return
+	}
+	data := p.GetTable().GetModel().Peek()
+	col := data.IndexOfHeader("COMMAND")
+	if col < 0 || col >= len(row.Fields) || strings.TrimSpace(row.Fields[col]) == "" {
+		return
+	}
+	if err := app.gotoResource(strings.TrimSpace(row.Fields[col]), "", false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// PluginDetails represents a plugin document output viewer.
+type PluginDetails struct {
+	*Details
+
+	spec        dao.PluginSpec
+	refreshRate time.Duration
+	cancelFn    context.CancelFunc
+}
+
+// NewPluginDetails returns a new viewer.
+func NewPluginDetails(app *App, title string, spec dao.PluginSpec, rate time.Duration) *PluginDetails {
+	return &PluginDetails{
+		Details:     NewDetails(app, title, spec.Binary, true),
+		spec:        spec,
+		refreshRate: rate,
+	}
+}
+
+// Start starts the plugin updater.
+func (p *PluginDetails) Start() {
+	p.Details.Start()
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(context.Background())
+	go p.updater(ctx)
+}
+
+// Stop terminates the plugin updater.
+func (p *PluginDetails) Stop() {
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+	p.Details.Stop()
+}
+
+func (p *PluginDetails) updater(ctx context.Context) {
+	for {
+		p.refresh(ctx)
+		if p.refreshRate <= 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.refreshRate):
+		}
+	}
+}
+
+func (p *PluginDetails) refresh(ctx context.Context) {
+	out, err := dao.RunPlugin(ctx, p.spec)
+	if ctx.Err() == context.Canceled {
+		return
+	}
+	p.app.QueueUpdateDraw(func() {
+		if err != nil {
+			p.app.Flash().Err(err)
+			return
+		}
+		if out.Title != "" {
+			p.SetSubject(out.Title)
+			p.updateTitle()
+		}
+		p.Update(out.Content)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showPlugin renders a plugin output in a table or details view.
+func showPlugin(app *App, p config.Plugin, args []string) {
+	spec := dao.PluginSpec{Binary: p.Command, Args: args}
+	rate := time.Duration(p.RefreshRate) * time.Second
+
+	var v model.Component
+	switch p.Output {
+	case config.PluginOutputDetails:
+		v = NewPluginDetails(app, p.Description, spec, rate)
+	default:
+		t := NewPluginTable(client.NewGVR("plugins")).(*PluginTable)
+		t.refreshRate = rate
+		t.SetContextFn(func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, internal.KeyPlugin, spec)
+		})
+		v = t
+	}
+	if err := app.inject(v); err != nil {
+		log.Error().Err(err).Msgf("Plugin %q view failed", p.Command)
+		app.Flash().Err(err)
+	}
+}
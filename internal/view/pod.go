@@ -69,6 +69,7 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Forensics", p.forensicsCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
@@ -128,6 +129,16 @@ func (p *Pod) showPFCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) forensicsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showForensics(p.App(), path)
+
+	return nil
+}
+
 func (p *Pod) portForwardContext(ctx context.Context) context.Context {
 	ctx = context.WithValue(ctx, internal.KeyBenchCfg, p.App().BenchFile)
 	return context.WithValue(ctx, internal.KeyPath, p.GetTable().GetSelectedItem())
@@ -66,6 +66,7 @@ func (p *Popeye) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Add(ui.KeyActions{
 		tcell.KeyEnter: ui.NewKeyAction("Goto", p.gotoCmd, true),
+		ui.KeyH:        ui.NewKeyAction("History", p.historyCmd, true),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", p.GetTable().SortColCmd("RESOURCE", true), false),
 		ui.KeyShiftS:   ui.NewKeyAction("Sort Score", p.GetTable().SortColCmd("SCORE%", true), false),
 		ui.KeyShiftO:   ui.NewKeyAction("Sort OK", p.GetTable().SortColCmd("OK", true), false),
@@ -89,6 +90,12 @@ func (p *Popeye) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Popeye) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	showPopeyeHistory(p.App())
+
+	return nil
+}
+
 func sanitizerCtx(path string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		ctx = context.WithValue(ctx, internal.KeyPath, path)
@@ -0,0 +1,245 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const (
+	popeyeRunsGVR  = "popeyeruns"
+	popeyeDiffsGVR = "popeyediffs"
+	trendSize      = 30
+)
+
+var trendTicks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// PopeyeHistory represents a sanitizer runs history view.
+type PopeyeHistory struct {
+	ResourceViewer
+}
+
+// NewPopeyeHistory returns a new view.
+func NewPopeyeHistory(gvr client.GVR) ResourceViewer {
+	p := PopeyeHistory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.PopeyeHistory{}.ColorerFunc())
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol(ageCol, true)
+	p.GetTable().SetDecorateFn(p.decorateRows)
+	p.GetTable().SetEnterFn(p.diffPrevious)
+	p.SetContextFn(p.historyContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PopeyeHistory) historyContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDir, dao.PopeyeHistoryDir(p.App().Config.Osc.CurrentCluster))
+}
+
+// decorateRows charts the score trend in the view title.
+func (p *PopeyeHistory) decorateRows(data render.TableData) render.TableData {
+	runs := make([]render.Row, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		runs = append(runs, re.Row)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].Fields[0] < runs[j].Fields[0]
+	})
+
+	scores := make([]int, 0, len(runs))
+	for _, r := range runs {
+		n, err := strconv.Atoi(r.Fields[2])
+		if err != nil {
+			continue
+		}
+		scores = append(scores, n)
+	}
+	if len(scores) == 0 {
+		p.GetTable().Extras = ""
+		return data
+	}
+	last := scores[len(scores)-1]
+	p.GetTable().Extras = fmt.Sprintf("Score %d -- %s %s", last, grade(last), scoreTrend(scores, trendSize))
+
+	return data
+}
+
+func (p *PopeyeHistory) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyD:      ui.NewKeyAction("Diff Marked", p.diffMarkedCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Score", p.GetTable().SortColCmd("SCORE%", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Error", p.GetTable().SortColCmd("ERROR", true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Warning", p.GetTable().SortColCmd("WARNING", true), false),
+	})
+}
+
+func (p *PopeyeHistory) diffPrevious(app *App, _ ui.Tabular, _, path string) {
+	runs, err := dao.LoadPopeyeRuns(filepath.Dir(path))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	base, ok := previousRun(runs, path)
+	if !ok {
+		app.Flash().Warnf("No previous run found for %s", runName(path))
+		return
+	}
+
+	showPopeyeDiff(app, base, path)
+}
+
+func (p *PopeyeHistory) diffMarkedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := p.GetTable().GetSelectedItems()
+	if len(paths) != 2 {
+		p.App().Flash().Warn("Mark two runs to diff")
+		return nil
+	}
+	sort.Strings(paths)
+	showPopeyeDiff(p.App(), paths[0], paths[1])
+
+	return nil
+}
+
+// PopeyeDiff represents issues changes between two sanitizer runs.
+type PopeyeDiff struct {
+	ResourceViewer
+
+	base, path string
+}
+
+// NewPopeyeDiff returns a new view.
+func NewPopeyeDiff(gvr client.GVR) ResourceViewer {
+	return newPopeyeDiff(gvr, "", "")
+}
+
+func newPopeyeDiff(gvr client.GVR, base, path string) *PopeyeDiff {
+	d := PopeyeDiff{
+		ResourceViewer: NewBrowser(gvr),
+		base:           base,
+		path:           path,
+	}
+	d.GetTable().SetColorerFn(render.PopeyeDiff{}.ColorerFunc())
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().SetSortCol("CHANGE", true)
+	d.GetTable().SetDecorateFn(d.decorateRows)
+	d.GetTable().SetEnterFn(d.gotoSection)
+	d.SetContextFn(d.diffContext)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *PopeyeDiff) diffContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, d.path)
+	return context.WithValue(ctx, internal.KeyBaseline, d.base)
+}
+
+func (d *PopeyeDiff) decorateRows(data render.TableData) render.TableData {
+	var added, resolved int
+	for _, re := range data.RowEvents {
+		switch re.Row.Fields[3] {
+		case render.IssueNew:
+			added++
+		case render.IssueResolved:
+			resolved++
+		}
+	}
+	d.GetTable().Extras = fmt.Sprintf("%s..%s New %d -- Resolved %d", runName(d.base), runName(d.path), added, resolved)
+
+	return data
+}
+
+func (d *PopeyeDiff) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Change", d.GetTable().SortColCmd("CHANGE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Section", d.GetTable().SortColCmd("SECTION", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", d.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Level", d.GetTable().SortColCmd("LEVEL", true), false),
+	})
+}
+
+// gotoSection shows the live sanitizer report for the selected issue section.
+func (d *PopeyeDiff) gotoSection(app *App, _ ui.Tabular, _, _ string) {
+	section := d.GetTable().GetSelectedCell(0)
+	if section == "" {
+		return
+	}
+	ns := client.CleanseNamespace(app.Config.ActiveNamespace())
+	v := NewSanitizer(client.NewGVR("sanitizer"))
+	v.SetContextFn(sanitizerCtx(client.FQN(ns, section)))
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showPopeyeHistory(app *App) {
+	if err := app.inject(NewPopeyeHistory(client.NewGVR(popeyeRunsGVR))); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func showPopeyeDiff(app *App, base, path string) {
+	if err := app.inject(newPopeyeDiff(client.NewGVR(popeyeDiffsGVR), base, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// previousRun returns the run preceding the given run in the same scope.
+func previousRun(runs []render.PopeyeRunInfo, path string) (string, bool) {
+	for i, r := range runs {
+		if r.Path != path {
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if runs[j].Run.Namespace == r.Run.Namespace {
+				return runs[j].Path, true
+			}
+		}
+		break
+	}
+
+	return "", false
+}
+
+func runName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// scoreTrend charts the last scores as a text sparkline.
+func scoreTrend(scores []int, size int) string {
+	if len(scores) > size {
+		scores = scores[len(scores)-size:]
+	}
+	var b strings.Builder
+	for _, s := range scores {
+		switch {
+		case s < 0:
+			s = 0
+		case s > 100:
+			s = 100
+		}
+		b.WriteRune(trendTicks[s*(len(trendTicks)-1)/100])
+	}
+
+	return b.String()
+}
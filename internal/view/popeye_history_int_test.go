@@ -0,0 +1,68 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreTrend(t *testing.T) {
+	uu := map[string]struct {
+		scores []int
+		size   int
+		e      string
+	}{
+		"empty": {size: 5},
+		"full": {
+			scores: []int{0, 50, 100},
+			size:   5,
+			e:      "▁▄█",
+		},
+		"clamped": {
+			scores: []int{-10, 200},
+			size:   5,
+			e:      "▁█",
+		},
+		"capped": {
+			scores: []int{0, 0, 100, 100},
+			size:   2,
+			e:      "██",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, scoreTrend(u.scores, u.size))
+		})
+	}
+}
+
+func TestPreviousRun(t *testing.T) {
+	runs := []render.PopeyeRunInfo{
+		{Path: "/h/r1.json", Run: &render.PopeyeRun{}},
+		{Path: "/h/r2_default.json", Run: &render.PopeyeRun{Namespace: "default"}},
+		{Path: "/h/r3.json", Run: &render.PopeyeRun{}},
+	}
+
+	uu := map[string]struct {
+		path string
+		e    string
+		ok   bool
+	}{
+		"scoped":  {path: "/h/r3.json", e: "/h/r1.json", ok: true},
+		"first":   {path: "/h/r1.json"},
+		"lonely":  {path: "/h/r2_default.json"},
+		"missing": {path: "/h/r4.json"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, ok := previousRun(runs, u.path)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
@@ -340,6 +340,10 @@ func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.Sp
 	s.SetLegend(fmt.Sprintf(" %s ", strings.Title(client.NewGVR(gvr).R())))
 	s.SetInputCapture(p.keyboard)
 	s.SetMultiSeries(true)
+	s.SetStatic(p.app.Config.Osc.IsAccessible())
+	if gvr == "cpu" || gvr == "mem" {
+		s.SetSeriesLabels("used", "allocatable")
+	}
 	p.AddItem(s, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
 	return s
@@ -357,6 +361,7 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 	}
 	g.SetLegend(fmt.Sprintf(" %s ", strings.Title(client.NewGVR(gvr).R())))
 	g.SetInputCapture(p.keyboard)
+	g.SetStatic(p.app.Config.Osc.IsAccessible())
 	p.AddItem(g, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
 	return g
@@ -0,0 +1,84 @@
+package view
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+// Quota represents a namespace quota utilization viewer.
+type Quota struct {
+	ResourceViewer
+}
+
+// NewQuota returns a new viewer.
+func NewQuota(gvr client.GVR) ResourceViewer {
+	q := Quota{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	q.AddBindKeysFn(q.bindKeys)
+	q.GetTable().SetColorerFn(q.colorer)
+	q.GetTable().SetEnterFn(q.showPods)
+
+	return &q
+}
+
+func (q *Quota) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Sort %Used", q.GetTable().SortColCmd("%USED", false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", q.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftQ: ui.NewKeyAction("Sort Requests", q.GetTable().SortColCmd("REQUESTS", false), false),
+	})
+}
+
+func (q *Quota) showPods(app *App, _ ui.Tabular, _, path string) {
+	ns, _ := client.Namespaced(path)
+	if err := app.gotoResource("pods "+ns, "", false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// colorer colors quota rows based on the configured thresholds.
+func (q *Quota) colorer(ns string, h render.Header, re render.RowEvent) tcell.Color {
+	c := render.Quota{}.ColorerFunc()(ns, h, re)
+	resCol, percCol := h.IndexOf("RESOURCE", true), h.IndexOf("%USED", true)
+	if resCol < 0 || percCol < 0 {
+		return c
+	}
+	n, err := strconv.Atoi(re.Row.Fields[percCol])
+	if err != nil {
+		return c
+	}
+	if n > 100 {
+		n = 100
+	}
+	if l, color := quotaSeverity(q.App().Config.Osc.Thresholds, re.Row.Fields[resCol], n); l != config.SeverityLow {
+		return tcell.GetColor(color)
+	}
+
+	return c
+}
+
+// quotaSeverity returns a quota utilization severity level and color. Resources
+// without a matching threshold ie pods, services,... use the most severe level
+// across all thresholds.
+func quotaSeverity(tt config.Threshold, res string, n int) (config.SeverityLevel, string) {
+	key := strings.TrimPrefix(strings.TrimPrefix(res, "requests."), "limits.")
+	if _, ok := tt[key]; ok {
+		return tt.LevelFor(key, n), tt.SeverityColor(key, n)
+	}
+
+	level, color := config.SeverityLow, ""
+	for k := range tt {
+		if l := tt.LevelFor(k, n); l > level {
+			level, color = l, tt.SeverityColor(k, n)
+		}
+	}
+
+	return level, color
+}
@@ -0,0 +1,36 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaSeverity(t *testing.T) {
+	tt := config.NewThreshold()
+	tt["cpu"] = &config.Severity{Critical: 90, Warn: 70}
+	tt["memory"] = &config.Severity{Critical: 60, Warn: 50}
+
+	uu := map[string]struct {
+		res   string
+		n     int
+		level config.SeverityLevel
+		color string
+	}{
+		"cpu-low":      {res: "requests.cpu", n: 60, level: config.SeverityLow, color: "green"},
+		"cpu-warn":     {res: "limits.cpu", n: 75, level: config.SeverityMedium, color: "orangered"},
+		"memory-crit":  {res: "requests.memory", n: 65, level: config.SeverityHigh, color: "red"},
+		"pods-highest": {res: "pods", n: 75, level: config.SeverityHigh, color: "red"},
+		"pods-low":     {res: "pods", n: 10, level: config.SeverityLow},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, c := quotaSeverity(tt, u.res, u.n)
+			assert.Equal(t, u.level, l)
+			assert.Equal(t, u.color, c)
+		})
+	}
+}
@@ -1,10 +1,7 @@
 package view
 
 import (
-	"strings"
-
 	"github.com/open-infra/osc/internal/client"
-	"github.com/open-infra/osc/internal/ui"
 )
 
 func loadCustomViewers() MetaViewers {
@@ -14,6 +11,8 @@ func loadCustomViewers() MetaViewers {
 	appsViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
+	autoscalingViewers(m)
+	policyViewers(m)
 	extViewers(m)
 	helmViewers(m)
 
@@ -90,6 +89,39 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("sanitizer")] = MetaViewer{
 		viewerFn: NewSanitizer,
 	}
+	vv[client.NewGVR("popeyeruns")] = MetaViewer{
+		viewerFn: NewPopeyeHistory,
+	}
+	vv[client.NewGVR("popeyediffs")] = MetaViewer{
+		viewerFn: NewPopeyeDiff,
+	}
+	vv[client.NewGVR("quotas")] = MetaViewer{
+		viewerFn: NewQuota,
+	}
+	vv[client.NewGVR("forensics")] = MetaViewer{
+		viewerFn: NewForensic,
+	}
+	vv[client.NewGVR("allocations")] = MetaViewer{
+		viewerFn: NewNodeAlloc,
+	}
+	vv[client.NewGVR("podallocations")] = MetaViewer{
+		viewerFn: NewPodAlloc,
+	}
+	vv[client.NewGVR("netchecks")] = MetaViewer{
+		viewerFn: NewNetCheck,
+	}
+	vv[client.NewGVR("certs")] = MetaViewer{
+		viewerFn: NewCert,
+	}
+	vv[client.NewGVR("routes")] = MetaViewer{
+		viewerFn: NewRoute,
+	}
+	vv[client.NewGVR("storage")] = MetaViewer{
+		viewerFn: NewStorage,
+	}
+	vv[client.NewGVR("plugins")] = MetaViewer{
+		viewerFn: NewPluginTable,
+	}
 }
 
 func appsViewers(vv MetaViewers) {
@@ -143,6 +175,24 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	for _, gvr := range []string{
+		"autoscaling/v1/horizontalpodautoscalers",
+		"autoscaling/v2beta1/horizontalpodautoscalers",
+		"autoscaling/v2beta2/horizontalpodautoscalers",
+	} {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			enterFn: showHPA,
+		}
+	}
+}
+
+func policyViewers(vv MetaViewers) {
+	vv[client.NewGVR(pdbGVR)] = MetaViewer{
+		viewerFn: NewPodDisruptionBudget,
+	}
+}
+
 func extViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		enterFn: showCRD,
@@ -151,11 +201,3 @@ func extViewers(vv MetaViewers) {
 		enterFn: showCRD,
 	}
 }
-
-func showCRD(app *App, _ ui.Tabular, _, path string) {
-	_, crdGVR := client.Namespaced(path)
-	tokens := strings.Split(crdGVR, ".")
-	if err := app.gotoResource(tokens[0], "", false); err != nil {
-		app.Flash().Err(err)
-	}
-}
@@ -3,7 +3,7 @@ package view
 import (
 	"context"
 	"errors"
-	"fmt"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/open-infra/osc/internal/dao"
@@ -42,20 +42,9 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	r.Stop()
 	defer r.Start()
-	msg := fmt.Sprintf("Restart deployment %s?", paths[0])
-	if len(paths) > 1 {
-		msg = fmt.Sprintf("Restart %d deployments?", len(paths))
-	}
+	msg := bulkMsg("Restart", strings.TrimSuffix(r.GVR().R(), "s"), paths)
 	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Restart", msg, func() {
-		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, path := range paths {
-			if err := r.restartRollout(ctx, path); err != nil {
-				r.App().Flash().Err(err)
-			} else {
-				r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
-			}
-		}
+		runBulk(r, "Restart", paths, r.restartRollout)
 	}, func() {})
 
 	return nil
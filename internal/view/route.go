@@ -0,0 +1,208 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/perf"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Route represents an ingress routes viewer.
+type Route struct {
+	ResourceViewer
+
+	bench *perf.Benchmark
+}
+
+// NewRoute returns a new viewer.
+func NewRoute(gvr client.GVR) ResourceViewer {
+	r := Route{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.AddBindKeysFn(r.bindKeys)
+	r.GetTable().SetColorerFn(render.Route{}.ColorerFunc())
+	r.GetTable().SetSortCol("HOST", true)
+	r.GetTable().SetEnterFn(r.showPods)
+
+	return &r
+}
+
+func (r *Route) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftF:   ui.NewKeyAction("Port-Forward", r.portFwdCmd, true),
+		tcell.KeyCtrlL: ui.NewKeyAction("Bench Run/Stop", r.toggleBenchCmd, true),
+		ui.KeyShiftH:   ui.NewKeyAction("Sort Host", r.GetTable().SortColCmd("HOST", true), false),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Service", r.GetTable().SortColCmd("SERVICE", true), false),
+	})
+}
+
+// showPods navigates to the pods backing the selected route service.
+func (r *Route) showPods(app *App, _ ui.Tabular, _, path string) {
+	route, err := r.route(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	svc, err := fetchService(app.factory, route.ServiceFQN())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	showPodsWithLabels(app, route.ServiceFQN(), svc.Spec.Selector)
+}
+
+func (r *Route) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	route, err := r.route(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	pod, ok := routePod(route)
+	if !ok {
+		r.App().Flash().Errf("No ready pods backing route %s%s", route.Host, route.Path)
+		return nil
+	}
+	if r.App().factory.Forwarders().IsPodForwarded(pod) {
+		r.App().Flash().Errf("A PortForward already exist for pod %s", pod)
+		return nil
+	}
+	mm, err := fetchPodPorts(r.App().factory, pod)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	ShowPortForwards(r, pod, routeFwdPorts(mm, route.TargetPort), startFwdCB)
+
+	return nil
+}
+
+func (r *Route) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if r.bench != nil {
+		r.App().Status(model.FlashErr, "Benchmark Canceled!")
+		r.bench.Cancel()
+		r.App().ClearStatus(true)
+		return nil
+	}
+
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	route, err := r.route(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	base, err := route.URL()
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	cfg := dao.RouteBenchConfig(r.App().BenchFile, route.ServiceFQN())
+	cfg.Name = route.ServiceFQN()
+
+	if r.bench, err = perf.NewBenchmark(base, r.App().version, cfg); err != nil {
+		r.App().Flash().Errf("Bench failed %v", err)
+		r.App().ClearStatus(false)
+		return nil
+	}
+	r.App().Status(model.FlashWarn, "Benchmark in progress...")
+	go r.runBenchmark()
+
+	return nil
+}
+
+func (r *Route) runBenchmark() {
+	log.Debug().Msg("Bench starting...")
+
+	r.bench.Run(r.App().Config.Osc.CurrentCluster, func() {
+		log.Debug().Msg("Bench Completed!")
+		r.App().QueueUpdate(func() {
+			if r.bench.Canceled() {
+				r.App().Status(model.FlashInfo, "Benchmark canceled")
+			} else {
+				r.App().Status(model.FlashInfo, "Benchmark Completed!")
+				r.bench.Cancel()
+			}
+			r.bench = nil
+			go func() {
+				<-time.After(2 * time.Second)
+				r.App().QueueUpdate(func() { r.App().ClearStatus(true) })
+			}()
+		})
+	})
+}
+
+func (r *Route) route(path string) (render.RouteRes, error) {
+	res, err := dao.AccessorFor(r.App().factory, r.GVR())
+	if err != nil {
+		return render.RouteRes{}, err
+	}
+	o, err := res.Get(context.Background(), path)
+	if err != nil {
+		return render.RouteRes{}, err
+	}
+	route, ok := o.(render.RouteRes)
+	if !ok {
+		return render.RouteRes{}, fmt.Errorf("expecting a route but got %T", o)
+	}
+
+	return route, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// routePod returns the first ready pod backing a route.
+func routePod(r render.RouteRes) (string, bool) {
+	for _, n := range r.Ready {
+		if net.ParseIP(n) == nil {
+			return client.FQN(r.Namespace, n), true
+		}
+	}
+
+	return "", false
+}
+
+// routeFwdPorts returns the pod ports matching the route target port or all
+// tcp ports if none match.
+func routeFwdPorts(mm map[string][]v1.ContainerPort, target string) []string {
+	var all, matches []string
+	for co, pp := range mm {
+		for _, p := range pp {
+			if p.Protocol != v1.ProtocolTCP {
+				continue
+			}
+			port := client.FQN(co, p.Name) + ":" + strconv.Itoa(int(p.ContainerPort))
+			all = append(all, port)
+			if strconv.Itoa(int(p.ContainerPort)) == target || p.Name == target {
+				matches = append(matches, port)
+			}
+		}
+	}
+	if len(matches) > 0 {
+		all = matches
+	}
+	sort.Strings(all)
+
+	return all
+}
@@ -0,0 +1,54 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRoutePod(t *testing.T) {
+	uu := map[string]struct {
+		route render.RouteRes
+		e     string
+		ok    bool
+	}{
+		"pod": {
+			route: render.RouteRes{Namespace: "default", Ready: []string{"10.0.0.1", "fred-1"}},
+			e:     "default/fred-1",
+			ok:    true,
+		},
+		"ipsOnly": {
+			route: render.RouteRes{Namespace: "default", Ready: []string{"10.0.0.1"}},
+		},
+		"notReady": {
+			route: render.RouteRes{Namespace: "default", NotReady: []string{"fred-1"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod, ok := routePod(u.route)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, pod)
+		})
+	}
+}
+
+func TestRouteFwdPorts(t *testing.T) {
+	mm := map[string][]v1.ContainerPort{
+		"web": {
+			{Name: "http", ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+			{Name: "dns", ContainerPort: 53, Protocol: v1.ProtocolUDP},
+		},
+		"sidecar": {
+			{Name: "metrics", ContainerPort: 9090, Protocol: v1.ProtocolTCP},
+		},
+	}
+
+	assert.Equal(t, []string{"web/http:8080"}, routeFwdPorts(mm, "8080"))
+	assert.Equal(t, []string{"sidecar/metrics:9090"}, routeFwdPorts(mm, "metrics"))
+	assert.Equal(t, []string{"sidecar/metrics:9090", "web/http:8080"}, routeFwdPorts(mm, "7070"))
+}
@@ -86,7 +86,7 @@ func (s *Sanitizer) Init(ctx context.Context) error {
 
 // ExtraHints returns additional hints.
 func (s *Sanitizer) ExtraHints() map[string]string {
-	if s.app.Config.Osc.NoIcons {
+	if s.app.Config.Osc.IsNoIcons() {
 		return nil
 	}
 	return xray.EmojiInfo()
@@ -258,7 +258,7 @@ func (s *Sanitizer) TreeLoadFailed(err error) {
 }
 
 func (s *Sanitizer) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, s.ExpandNodes(), s.app.Config.Osc.NoIcons, s.app.Styles)
+	root := makeTreeNode(node, s.ExpandNodes(), s.app.Config.Osc.IsNoIcons(), s.app.Styles)
 	if node == nil {
 		s.app.QueueUpdateDraw(func() {
 			s.SetRoot(root)
@@ -305,7 +305,7 @@ func (s *Sanitizer) TreeChanged(node *xray.TreeNode) {
 }
 
 func (s *Sanitizer) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, s.ExpandNodes(), s.app.Config.Osc.NoIcons, s.app.Styles)
+	node := makeTreeNode(n, s.ExpandNodes(), s.app.Config.Osc.IsNoIcons(), s.app.Styles)
 	for _, c := range n.Children {
 		s.hydrate(node, c)
 	}
@@ -36,21 +36,25 @@ func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
 }
 
 func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := s.GetTable().GetSelectedItem()
-	if path == "" {
+	paths := s.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return nil
 	}
 
 	s.Stop()
 	defer s.Start()
-	s.showScaleDialog(path)
+	s.showScaleDialog(paths)
 
 	return nil
 }
 
-func (s *ScaleExtender) showScaleDialog(path string) {
-	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path))
-	confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), path))
+func (s *ScaleExtender) showScaleDialog(paths []string) {
+	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(paths))
+	if len(paths) == 1 {
+		confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), paths[0]))
+	} else {
+		confirm.SetText(fmt.Sprintf("Scale %d %s", len(paths), s.GVR()))
+	}
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -58,7 +62,7 @@ func (s *ScaleExtender) showScaleDialog(path string) {
 	s.App().Content.ShowPage(scaleDialogKey)
 }
 
-func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
+func (s *ScaleExtender) makeScaleForm(paths []string) *tview.Form {
 	f := s.makeStyledForm()
 	replicas := strings.TrimSpace(s.GetTable().GetCell(s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1).Text)
 	tokens := strings.Split(replicas, "/")
@@ -77,14 +81,13 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		if err := s.scale(ctx, sel, count); err != nil {
-			log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-			s.App().Flash().Err(err)
-		} else {
-			s.App().Flash().Infof("Resource %s:%s scaled successfully", s.GVR(), sel)
-		}
+		runBulk(s, "Scale", paths, func(ctx context.Context, path string) error {
+			if err := s.scale(ctx, path, count); err != nil {
+				log.Error().Err(err).Msgf("%s scaling failed", path)
+				return err
+			}
+			return nil
+		})
 	})
 
 	f.AddButton("Cancel", func() {
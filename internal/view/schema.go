@@ -0,0 +1,250 @@
+package view
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+)
+
+const schemaDetailsSize = 40
+
+// SchemaTree renders an OpenAPI schema as a collapsible fields tree along
+// with the selected field details.
+type SchemaTree struct {
+	*tview.Flex
+
+	tree     *tview.TreeView
+	details  *tview.TextView
+	styles   *config.Styles
+	expanded bool
+	matches  []*tview.TreeNode
+	current  int
+}
+
+// NewSchemaTree returns a new schema tree.
+func NewSchemaTree(styles *config.Styles) *SchemaTree {
+	s := SchemaTree{
+		Flex:    tview.NewFlex(),
+		tree:    tview.NewTreeView(),
+		details: tview.NewTextView(),
+		styles:  styles,
+	}
+	s.tree.SetGraphics(true)
+	s.tree.SetChangedFunc(s.selectionChanged)
+	s.tree.SetSelectedFunc(func(n *tview.TreeNode) {
+		n.SetExpanded(!n.IsExpanded())
+	})
+	s.details.SetDynamicColors(true)
+	s.details.SetWrap(true).SetWordWrap(true)
+	s.details.SetBorderPadding(0, 0, 1, 1)
+	s.SetDirection(tview.FlexColumn)
+	s.AddItem(s.tree, 0, 1, true)
+	s.AddItem(s.details, schemaDetailsSize, 0, false)
+	s.StylesChanged(styles)
+
+	return &s
+}
+
+// SetRoot renders the given schema.
+func (s *SchemaTree) SetRoot(f *dao.SchemaField) {
+	s.details.Clear()
+	s.matches, s.current = nil, 0
+	if f == nil {
+		s.tree.SetRoot(nil)
+		return
+	}
+	root := s.makeNode(f)
+	root.SetExpanded(true)
+	s.tree.SetRoot(root)
+	s.tree.SetCurrentNode(root)
+	s.selectionChanged(root)
+}
+
+// Select selects the field at the given dotted path and expands its parents.
+func (s *SchemaTree) Select(path string) bool {
+	root := s.tree.GetRoot()
+	if root == nil {
+		return false
+	}
+	var found *tview.TreeNode
+	parents := make(map[*tview.TreeNode]*tview.TreeNode)
+	root.Walk(func(n, parent *tview.TreeNode) bool {
+		parents[n] = parent
+		if f, ok := n.GetReference().(*dao.SchemaField); ok && f.Path == path {
+			found = n
+		}
+		return found == nil
+	})
+	if found == nil {
+		return false
+	}
+	s.reveal(found, parents)
+
+	return true
+}
+
+// Search selects the first field which path matches the given query and
+// returns the number of matches.
+func (s *SchemaTree) Search(q string) int {
+	s.matches, s.current = nil, 0
+	root := s.tree.GetRoot()
+	if root == nil || q == "" {
+		return 0
+	}
+	rx, err := regexp.Compile("(?i)" + q)
+	if err != nil {
+		rx = regexp.MustCompile("(?i)" + regexp.QuoteMeta(q))
+	}
+	parents := make(map[*tview.TreeNode]*tview.TreeNode)
+	root.Walk(func(n, parent *tview.TreeNode) bool {
+		parents[n] = parent
+		if f, ok := n.GetReference().(*dao.SchemaField); ok && f.Path != "" && rx.MatchString(f.Path) {
+			s.matches = append(s.matches, n)
+		}
+		return true
+	})
+	for _, n := range s.matches {
+		for p := parents[n]; p != nil; p = parents[p] {
+			p.SetExpanded(true)
+		}
+	}
+	if len(s.matches) > 0 {
+		s.reveal(s.matches[0], parents)
+	}
+
+	return len(s.matches)
+}
+
+// NextMatch selects the next or previous search match and returns its
+// position.
+func (s *SchemaTree) NextMatch(delta int) (int, int) {
+	if len(s.matches) == 0 {
+		return 0, 0
+	}
+	s.current = (s.current + delta + len(s.matches)) % len(s.matches)
+	s.tree.SetCurrentNode(s.matches[s.current])
+	s.selectionChanged(s.matches[s.current])
+
+	return s.current + 1, len(s.matches)
+}
+
+func (s *SchemaTree) reveal(n *tview.TreeNode, parents map[*tview.TreeNode]*tview.TreeNode) {
+	for p := n; p != nil; p = parents[p] {
+		p.SetExpanded(true)
+	}
+	s.tree.SetCurrentNode(n)
+	s.selectionChanged(n)
+}
+
+// SelectedField returns the currently selected field if any.
+func (s *SchemaTree) SelectedField() *dao.SchemaField {
+	n := s.tree.GetCurrentNode()
+	if n == nil {
+		return nil
+	}
+	f, _ := n.GetReference().(*dao.SchemaField)
+
+	return f
+}
+
+// ToggleAll expands or collapses all fields.
+func (s *SchemaTree) ToggleAll() {
+	root := s.tree.GetRoot()
+	if root == nil {
+		return
+	}
+	s.expanded = !s.expanded
+	root.Walk(func(n, parent *tview.TreeNode) bool {
+		if parent != nil {
+			n.SetExpanded(s.expanded)
+		}
+		return true
+	})
+}
+
+// StylesChanged notifies the skin changed.
+func (s *SchemaTree) StylesChanged(styles *config.Styles) {
+	s.styles = styles
+	s.SetBackgroundColor(styles.BgColor())
+	s.tree.SetBackgroundColor(styles.Xray().BgColor.Color())
+	s.tree.SetGraphicsColor(styles.Xray().GraphicColor.Color())
+	s.details.SetBackgroundColor(styles.BgColor())
+	s.details.SetTextColor(styles.FgColor())
+	if root := s.tree.GetRoot(); root != nil {
+		root.Walk(func(n, _ *tview.TreeNode) bool {
+			if f, ok := n.GetReference().(*dao.SchemaField); ok {
+				n.SetColor(s.fieldColor(f).Color())
+			}
+			return true
+		})
+	}
+}
+
+func (s *SchemaTree) makeNode(f *dao.SchemaField) *tview.TreeNode {
+	n := tview.NewTreeNode(schemaLabel(f))
+	n.SetReference(f)
+	n.SetColor(s.fieldColor(f).Color())
+	n.SetExpanded(false)
+	for _, c := range f.Fields {
+		n.AddChild(s.makeNode(c))
+	}
+
+	return n
+}
+
+func (s *SchemaTree) fieldColor(f *dao.SchemaField) config.Color {
+	if f.Required {
+		return s.styles.Frame().Status.HighlightColor
+	}
+
+	return s.styles.Xray().FgColor
+}
+
+func (s *SchemaTree) selectionChanged(n *tview.TreeNode) {
+	f, ok := n.GetReference().(*dao.SchemaField)
+	if !ok {
+		return
+	}
+	s.details.SetText(schemaDetails(f, s.styles))
+	s.details.ScrollToBeginning()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func schemaLabel(f *dao.SchemaField) string {
+	label := f.Name + " <" + f.Type + ">"
+	if f.Required {
+		label += " *"
+	}
+
+	return label
+}
+
+func schemaDetails(f *dao.SchemaField, s *config.Styles) string {
+	section, fg := s.K9s.Info.SectionColor.String(), s.Body().FgColor.String()
+
+	var b strings.Builder
+	field := func(k, v string) {
+		if v == "" {
+			return
+		}
+		fmt.Fprintf(&b, "[%s::b]%s:[%s::-] %s\n", section, k, fg, tview.Escape(v))
+	}
+	field("Field", f.Path)
+	field("Type", f.Type)
+	if f.Required {
+		field("Required", "true")
+	}
+	field("Default", f.Default)
+	field("Enum", strings.Join(f.Enum, ", "))
+	if f.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", tview.Escape(f.Description))
+	}
+
+	return b.String()
+}
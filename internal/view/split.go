@@ -0,0 +1,290 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/model"
+	"github.com/open-infra/osc/internal/ui"
+)
+
+const (
+	splitTitle    = "split"
+	splitSep      = "|"
+	logsPaneCmd   = "logs"
+	splitCmd      = "split"
+	vsplitCmd     = "vsplit"
+	splitUsageFmt = "Usage: %s CMD1 %s CMD2"
+)
+
+// Split represents a two panes layout with independently refreshed views.
+type Split struct {
+	*tview.Flex
+
+	app     *App
+	layout  config.Split
+	panes   []model.Component
+	focus   int
+	actions ui.KeyActions
+}
+
+// NewSplit returns a new split view.
+func NewSplit(layout config.Split) *Split {
+	return &Split{
+		Flex:    tview.NewFlex(),
+		layout:  layout,
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the view.
+func (s *Split) Init(ctx context.Context) (err error) {
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	if s.layout.IsVertical() {
+		s.SetDirection(tview.FlexRow)
+	} else {
+		s.SetDirection(tview.FlexColumn)
+	}
+
+	s.panes = make([]model.Component, 0, len(s.layout.Panes))
+	for i, spec := range s.layout.Panes {
+		c, ns, err := s.app.command.paneFor(spec)
+		if err != nil {
+			return err
+		}
+		if err := c.Init(ctx); err != nil {
+			return err
+		}
+		if ns != "" {
+			setPaneNamespace(c, ns)
+		}
+		s.panes = append(s.panes, c)
+		s.AddItem(c, 0, 1, i == s.focus)
+	}
+	s.bindKeys()
+	s.SetInputCapture(s.keyboard)
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Split) Name() string { return splitTitle }
+
+// Start starts all panes.
+func (s *Split) Start() {
+	for _, p := range s.panes {
+		p.Start()
+	}
+	s.focusPane(s.focus)
+}
+
+// Stop terminates all panes.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.Stop()
+	}
+}
+
+// Focus delegates focus to the focused pane.
+func (s *Split) Focus(delegate func(p tview.Primitive)) {
+	if p := s.focused(); p != nil {
+		delegate(p)
+	}
+}
+
+// Hints returns the focused pane hints.
+func (s *Split) Hints() model.MenuHints {
+	hh := s.actions.Hints()
+	if p := s.focused(); p != nil {
+		hh = append(p.Hints(), hh...)
+	}
+
+	return hh
+}
+
+// ExtraHints returns the focused pane additional hints.
+func (s *Split) ExtraHints() map[string]string {
+	if p := s.focused(); p != nil {
+		return p.ExtraHints()
+	}
+
+	return nil
+}
+
+// HasTable returns true if the given table belongs to one of the panes.
+func (s *Split) HasTable(t *Table) bool {
+	for _, p := range s.panes {
+		if paneTable(p) == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFocused returns true if the given table belongs to the focused pane.
+func (s *Split) IsFocused(t *Table) bool {
+	return paneTable(s.focused()) == t
+}
+
+func (s *Split) bindKeys() {
+	s.actions.Add(ui.KeyActions{
+		tcell.KeyTab:     ui.NewSharedKeyAction("Next Pane", s.nextPaneCmd(1), true),
+		tcell.KeyBacktab: ui.NewSharedKeyAction("Prev Pane", s.nextPaneCmd(-1), true),
+	})
+}
+
+func (s *Split) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := s.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (s *Split) nextPaneCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if len(s.panes) == 0 {
+			return evt
+		}
+		s.focusPane((s.focus + direction + len(s.panes)) % len(s.panes))
+
+		return nil
+	}
+}
+
+func (s *Split) focusPane(i int) {
+	if i < 0 || i >= len(s.panes) {
+		return
+	}
+	s.focus = i
+	s.app.SetFocus(s.panes[i])
+	s.app.Menu().HydrateMenu(s.Hints())
+}
+
+func (s *Split) focused() model.Component {
+	if s.focus < 0 || s.focus >= len(s.panes) {
+		return nil
+	}
+
+	return s.panes[s.focus]
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (c *Command) splitCmd(cmd string) error {
+	layout, err := parseSplit(cmd)
+	if err != nil {
+		return err
+	}
+	if layout == nil {
+		if layout = c.app.Config.ActiveSplit(); !layout.IsValid() {
+			return fmt.Errorf(splitUsageFmt, splitCmd, splitSep)
+		}
+	}
+
+	v := NewSplit(*layout)
+	if err := v.Init(context.WithValue(context.Background(), internal.KeyApp, c.app)); err != nil {
+		return err
+	}
+	c.app.Config.SetActiveSplit(layout)
+	if err := c.app.Config.Save(); err != nil {
+		return err
+	}
+	c.app.Content.Push(v)
+
+	return nil
+}
+
+// parseSplit parses a split command. It returns no layout when no panes are given.
+func parseSplit(cmd string) (*config.Split, error) {
+	tokens := strings.SplitN(strings.TrimSpace(cmd), " ", 2)
+	layout := config.Split{Orientation: config.SplitHorizontal}
+	if tokens[0] == vsplitCmd {
+		layout.Orientation = config.SplitVertical
+	}
+	if len(tokens) == 1 || strings.TrimSpace(tokens[1]) == "" {
+		return nil, nil
+	}
+
+	for _, p := range strings.Split(tokens[1], splitSep) {
+		p = strings.Join(strings.Fields(p), " ")
+		if p == "" {
+			return nil, fmt.Errorf(splitUsageFmt, tokens[0], splitSep)
+		}
+		layout.Panes = append(layout.Panes, p)
+	}
+	if !layout.IsValid() {
+		return nil, fmt.Errorf(splitUsageFmt, tokens[0], splitSep)
+	}
+
+	return &layout, nil
+}
+
+// paneFor returns a pane component and its namespace given a view command.
+// Panes are either resource commands `CMD [NAMESPACE]` or `logs NS/POD [CONTAINER]`.
+func (c *Command) paneFor(spec string) (model.Component, string, error) {
+	tokens := strings.Fields(spec)
+	if len(tokens) == 0 {
+		return nil, "", errors.New("blank pane command")
+	}
+	if tokens[0] == logsPaneCmd {
+		if len(tokens) < 2 {
+			return nil, "", errors.New("You must specify a pod as NS/NAME")
+		}
+		var co string
+		if len(tokens) == 3 {
+			co = tokens[2]
+		}
+		return NewLog(client.NewGVR("v1/pods"), tokens[1], co, false), "", nil
+	}
+
+	if !c.alias.Check(tokens[0]) {
+		return nil, "", fmt.Errorf("`%s` Command not found", spec)
+	}
+	gvr, v, err := c.viewMetaFor(tokens[0])
+	if err != nil {
+		return nil, "", err
+	}
+	var ns string
+	if len(tokens) == 2 {
+		ns = tokens[1]
+		if ok, err := c.app.isValidNS(ns); err != nil || !ok {
+			return nil, "", fmt.Errorf("Invalid namespace %q", ns)
+		}
+	}
+
+	return c.componentFor(gvr, "", v), ns, nil
+}
+
+// setPaneNamespace scopes a namespaced resource pane to a given namespace.
+func setPaneNamespace(c model.Component, ns string) {
+	t := paneTable(c)
+	if t == nil {
+		return
+	}
+	meta, err := dao.MetaAccess.MetaFor(t.GVR())
+	if err != nil || !meta.Namespaced {
+		return
+	}
+	t.GetModel().SetNamespace(client.CleanseNamespace(ns))
+}
+
+func paneTable(c model.Component) *Table {
+	if v, ok := c.(ResourceViewer); ok {
+		return v.GetTable()
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSplit(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   *config.Split
+		err bool
+	}{
+		"restore": {
+			cmd: "split",
+		},
+		"horizontal": {
+			cmd: "split dp kube-system | events  kube-system",
+			e:   &config.Split{Orientation: config.SplitHorizontal, Panes: []string{"dp kube-system", "events kube-system"}},
+		},
+		"vertical": {
+			cmd: "vsplit po|logs default/fred blee",
+			e:   &config.Split{Orientation: config.SplitVertical, Panes: []string{"po", "logs default/fred blee"}},
+		},
+		"one-pane": {
+			cmd: "split po",
+			err: true,
+		},
+		"blank-pane": {
+			cmd: "split po | ",
+			err: true,
+		},
+		"three-panes": {
+			cmd: "split po | dp | svc",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := parseSplit(u.cmd)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestSplitNew(t *testing.T) {
+	s := NewSplit(config.Split{Panes: []string{"po", "dp"}})
+
+	assert.Equal(t, splitTitle, s.Name())
+	assert.Nil(t, s.ExtraHints())
+	assert.Equal(t, 0, len(s.Hints()))
+	assert.False(t, s.HasTable(NewTable(client.NewGVR("v1/pods"))))
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/open-infra/osc/internal/client"
+	"github.com/open-infra/osc/internal/dao"
+	"github.com/open-infra/osc/internal/render"
+	"github.com/open-infra/osc/internal/ui"
+	"github.com/open-infra/osc/internal/ui/dialog"
+)
+
+// Storage represents a volumes lifecycle viewer.
+type Storage struct {
+	ResourceViewer
+}
+
+// NewStorage returns a new viewer.
+func NewStorage(gvr client.GVR) ResourceViewer {
+	s := Storage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+	s.GetTable().SetColorerFn(render.Storage{}.ColorerFunc())
+	s.GetTable().SetSortCol("VOLUME", true)
+	s.GetTable().SetEnterFn(s.describe)
+
+	return &s
+}
+
+func (s *Storage) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyD)
+	if !s.App().Config.Osc.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Reclaim", s.reclaimCmd, true),
+		})
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Claim", s.GetTable().SortColCmd("CLAIM", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", s.GetTable().SortColCmd("PV-STATUS", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort %Used", s.GetTable().SortColCmd("%USED", false), false),
+	})
+}
+
+// describe describes the selected claim or its volume if not claimed.
+func (s *Storage) describe(app *App, m ui.Tabular, _, path string) {
+	if claim := s.selectedClaim(path); claim != "" {
+		describeResource(app, m, "v1/persistentvolumeclaims", claim)
+		return
+	}
+	if isVolume(path) {
+		describeResource(app, m, "v1/persistentvolumes", path)
+	}
+}
+
+func (s *Storage) reclaimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	var paths []string
+	for _, p := range s.GetTable().GetSelectedItems() {
+		if isVolume(p) {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		s.App().Flash().Warn("No volumes selected")
+		return nil
+	}
+
+	res, err := dao.AccessorFor(s.App().factory, client.NewGVR("v1/persistentvolumes"))
+	if err != nil {
+		return nil
+	}
+	reclaimer, ok := res.(dao.Reclaimer)
+	if !ok {
+		s.App().Flash().Err(fmt.Errorf("expecting a reclaimable resource for %q", res.GVR()))
+		return nil
+	}
+	dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, "Reclaim", bulkMsg("Reclaim", "volume", paths), func() {
+		runBulk(s, "Reclaim", paths, reclaimer.Reclaim)
+	}, func() {})
+
+	return nil
+}
+
+func (s *Storage) selectedClaim(path string) string {
+	row, ok := s.GetTable().GetSelectedRow(path)
+	if !ok {
+		return ""
+	}
+	data := s.GetTable().GetModel().Peek()
+	ns, claim := data.IndexOfHeader("NAMESPACE"), data.IndexOfHeader("CLAIM")
+	if ns < 0 || claim < 0 || claim >= len(row.Fields) {
+		return ""
+	}
+
+	return storageClaim(row.Fields[ns], row.Fields[claim])
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// storageClaim returns the claim fully qualified name or blank if unclaimed.
+func storageClaim(ns, claim string) string {
+	ns, claim = strings.TrimSpace(ns), strings.TrimSpace(claim)
+	if claim == "" || claim == render.MissingValue {
+		return ""
+	}
+
+	return client.FQN(ns, claim)
+}
+
+// isVolume checks if a storage row identifies a volume vs an unbound claim.
+func isVolume(path string) bool {
+	return path != "" && !strings.Contains(path, "/")
+}
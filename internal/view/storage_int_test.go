@@ -0,0 +1,30 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageClaim(t *testing.T) {
+	uu := map[string]struct {
+		ns, claim, e string
+	}{
+		"claimed":   {ns: "default", claim: "fred", e: "default/fred"},
+		"unclaimed": {ns: "<none>", claim: "<none>"},
+		"padded":    {ns: " default ", claim: "fred ", e: "default/fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, storageClaim(u.ns, u.claim))
+		})
+	}
+}
+
+func TestIsVolume(t *testing.T) {
+	assert.True(t, isVolume("pv-1"))
+	assert.False(t, isVolume("default/fred"))
+	assert.False(t, isVolume(""))
+}
@@ -43,6 +43,7 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	}
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, t.app.CustomView)
+	ctx = context.WithValue(ctx, internal.KeyAccessible, t.app.Config.Osc.IsAccessible())
 	t.Table.Init(ctx)
 	t.SetInputCapture(t.keyboard)
 	t.bindKeys()
@@ -167,9 +168,19 @@ func (t *Table) bindKeys() {
 		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
 		ui.KeyShiftN:           ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false),
 		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
+		ui.KeyShiftG:           ui.NewKeyAction("Group By", t.groupCmd, false),
 	})
 }
 
+func (t *Table) groupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleGroup()
+	if col, mode := t.GroupBy(); mode != ui.GroupNone {
+		t.app.Flash().Infof("Grouping by %s", col)
+	}
+
+	return nil
+}
+
 func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleToast()
 	return nil
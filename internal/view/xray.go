@@ -94,7 +94,7 @@ func (x *Xray) Init(ctx context.Context) error {
 
 // ExtraHints returns additional hints.
 func (x *Xray) ExtraHints() map[string]string {
-	if x.app.Config.Osc.NoIcons {
+	if x.app.Config.Osc.IsNoIcons() {
 		return nil
 	}
 	return xray.EmojiInfo()
@@ -124,6 +124,7 @@ func (x *Xray) refreshActions() {
 		hotKeyActions(x, aa)
 
 		x.Actions().Add(aa)
+		keymapActions(x.app, x.Aliases(), x.Actions())
 		x.app.Menu().HydrateMenu(x.Hints())
 	}()
 
@@ -327,7 +328,7 @@ func (x *Xray) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(x.app, "YAML", spec.Path(), true).Update(raw)
+	details := NewDetails(x.app, "YAML", spec.Path(), true).SetExplainGVR(client.NewGVR(spec.GVR())).Update(raw)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -489,7 +490,7 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.Osc.NoIcons, x.app.Styles)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.Osc.IsNoIcons(), x.app.Styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -536,7 +537,7 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 }
 
 func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.Osc.NoIcons, x.app.Styles)
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.Osc.IsNoIcons(), x.app.Styles)
 	for _, c := range n.Children {
 		x.hydrate(node, c)
 	}
@@ -25,6 +25,7 @@ type Factory struct {
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	notifiers  map[string]*notifier
 	mx         sync.RWMutex
 }
 
@@ -34,6 +35,7 @@ func NewFactory(client client.Connection) *Factory {
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
 		forwarders: NewForwarders(),
+		notifiers:  make(map[string]*notifier),
 	}
 }
 
@@ -62,6 +64,9 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	for k := range f.notifiers {
+		delete(f.notifiers, k)
+	}
 	f.forwarders.DeleteAll()
 }
 
@@ -0,0 +1,131 @@
+package watch
+
+import (
+	"sync"
+
+	"github.com/open-infra/osc/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ResourceEventKind represents an informer event type.
+type ResourceEventKind int
+
+const (
+	// ResourceAdded represents a new resource.
+	ResourceAdded ResourceEventKind = iota
+
+	// ResourceUpdated represents a resource update.
+	ResourceUpdated
+
+	// ResourceDeleted represents a resource deletion.
+	ResourceDeleted
+)
+
+// ResourceEvent represents an informer event.
+type ResourceEvent struct {
+	Kind   ResourceEventKind
+	Object runtime.Object
+}
+
+// ResourceListener represents an informer events listener.
+type ResourceListener interface {
+	// ResourceChanged notifies a resource was added, updated or deleted.
+	ResourceChanged(ResourceEvent)
+}
+
+// notifier fans out informer events to listeners. Informer handlers can not
+// be removed, so a single notifier is registered per informer.
+type notifier struct {
+	listeners []ResourceListener
+	mx        sync.RWMutex
+}
+
+func (n *notifier) addListener(l ResourceListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	n.listeners = append(n.listeners, l)
+}
+
+func (n *notifier) removeListener(l ResourceListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	for i, lis := range n.listeners {
+		if lis == l {
+			n.listeners = append(n.listeners[:i], n.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+func (n *notifier) fire(kind ResourceEventKind, obj interface{}) {
+	if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	o, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+	for _, l := range n.listeners {
+		l.ResourceChanged(ResourceEvent{Kind: kind, Object: o})
+	}
+}
+
+// OnAdd notifies a resource was added.
+func (n *notifier) OnAdd(obj interface{}) {
+	n.fire(ResourceAdded, obj)
+}
+
+// OnUpdate notifies a resource was updated.
+func (n *notifier) OnUpdate(_, obj interface{}) {
+	n.fire(ResourceUpdated, obj)
+}
+
+// OnDelete notifies a resource was deleted.
+func (n *notifier) OnDelete(obj interface{}) {
+	n.fire(ResourceDeleted, obj)
+}
+
+// AddListener registers a listener for a given resource informer events.
+func (f *Factory) AddListener(ns, gvr string, l ResourceListener) error {
+	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+
+	key := notifierKey(ns, gvr)
+	f.mx.Lock()
+	n, ok := f.notifiers[key]
+	if !ok {
+		n = &notifier{}
+		f.notifiers[key] = n
+	}
+	f.mx.Unlock()
+	if !ok {
+		inf.Informer().AddEventHandler(n)
+	}
+	n.addListener(l)
+
+	return nil
+}
+
+// RemoveListener unregisters a resource informer events listener.
+func (f *Factory) RemoveListener(ns, gvr string, l ResourceListener) {
+	f.mx.RLock()
+	n, ok := f.notifiers[notifierKey(ns, gvr)]
+	f.mx.RUnlock()
+	if ok {
+		n.removeListener(l)
+	}
+}
+
+func notifierKey(ns, gvr string) string {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
+	return ns + ":" + gvr
+}